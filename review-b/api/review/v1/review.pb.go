@@ -8,9 +8,11 @@ package v1
 
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -38,6 +40,9 @@ type CreateReviewRequest struct {
 	PicInfo      string `protobuf:"bytes,8,opt,name=picInfo,proto3" json:"picInfo,omitempty"`
 	VideoInfo    string `protobuf:"bytes,9,opt,name=videoInfo,proto3" json:"videoInfo,omitempty"`
 	Anonymous    bool   `protobuf:"varint,10,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	// 评价的商品，同一商品下的评价按内容去重
+	SpuID int64 `protobuf:"varint,11,opt,name=spuID,proto3" json:"spuID,omitempty"`
+	SkuID int64 `protobuf:"varint,12,opt,name=skuID,proto3" json:"skuID,omitempty"`
 }
 
 func (x *CreateReviewRequest) Reset() {
@@ -142,6 +147,20 @@ func (x *CreateReviewRequest) GetAnonymous() bool {
 	return false
 }
 
+func (x *CreateReviewRequest) GetSpuID() int64 {
+	if x != nil {
+		return x.SpuID
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetSkuID() int64 {
+	if x != nil {
+		return x.SkuID
+	}
+	return 0
+}
+
 // 创建评价的回复
 type CreateReviewReply struct {
 	state         protoimpl.MessageState
@@ -302,6 +321,12 @@ type ReviewInfo struct {
 	PicInfo      string `protobuf:"bytes,8,opt,name=picInfo,proto3" json:"picInfo,omitempty"`
 	VideoInfo    string `protobuf:"bytes,9,opt,name=videoInfo,proto3" json:"videoInfo,omitempty"`
 	Status       int32  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	// 评价者时区，相对UTC的分钟数
+	TimezoneOffset int32 `protobuf:"varint,11,opt,name=timezoneOffset,proto3" json:"timezoneOffset,omitempty"`
+	// 评价者本地时间表示的创建时间，RFC3339格式
+	CreateAt string `protobuf:"bytes,12,opt,name=createAt,proto3" json:"createAt,omitempty"`
+	// 异常分数，只在O端接口返回
+	AnomalyScore float64 `protobuf:"fixed64,13,opt,name=anomalyScore,proto3" json:"anomalyScore,omitempty"`
 }
 
 func (x *ReviewInfo) Reset() {
@@ -406,13 +431,35 @@ func (x *ReviewInfo) GetStatus() int32 {
 	return 0
 }
 
+func (x *ReviewInfo) GetTimezoneOffset() int32 {
+	if x != nil {
+		return x.TimezoneOffset
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetAnomalyScore() float64 {
+	if x != nil {
+		return x.AnomalyScore
+	}
+	return 0
+}
+
 // 审核评价的请求
 type AuditReviewRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReviewID  int64   `protobuf:"varint,1,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
+	ReviewID int64 `protobuf:"varint,1,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
+	// 审核结果：20审核通过；30审核不通过
 	Status    int32   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	OpUser    string  `protobuf:"bytes,3,opt,name=opUser,proto3" json:"opUser,omitempty"`
 	OpReason  string  `protobuf:"bytes,4,opt,name=opReason,proto3" json:"opReason,omitempty"`
@@ -1037,313 +1084,3120 @@ func (x *ListReviewByUserIDReply) GetList() []*ReviewInfo {
 	return nil
 }
 
-var File_api_review_v1_review_proto protoreflect.FileDescriptor
+// 批量打标签的请求，筛选条件为零值时表示不限制
+type BulkTagReviewsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_api_review_v1_review_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x76, 0x31, 0x2f,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x61, 0x70,
-	0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x89, 0x03, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
-	0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x07, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
-	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x21,
-	0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49,
-	0x44, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x0f, 0xfa, 0x42, 0x0c, 0x1a, 0x0a, 0x30, 0x01, 0x30, 0x02, 0x30, 0x03, 0x30, 0x04, 0x30,
-	0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0f,
-	0xfa, 0x42, 0x0c, 0x1a, 0x0a, 0x30, 0x01, 0x30, 0x02, 0x30, 0x03, 0x30, 0x04, 0x30, 0x05, 0x52,
-	0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x33, 0x0a,
-	0x0c, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x0f, 0xfa, 0x42, 0x0c, 0x1a, 0x0a, 0x30, 0x01, 0x30, 0x02, 0x30, 0x03,
-	0x30, 0x04, 0x30, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x08, 0x18, 0xff, 0x01, 0x52,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x49,
-	0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x69, 0x63, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x22, 0x2f,
-	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22,
-	0x37, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x3f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa2, 0x02, 0x0a, 0x0a, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xd1,
-	0x01, 0x0a, 0x12, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
-	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a,
-	0x02, 0x20, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x6f,
-	0x70, 0x55, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x02, 0x52, 0x06, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x08,
-	0x6f, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x02, 0x52, 0x08, 0x6f, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x12, 0x21, 0x0a, 0x09, 0x6f, 0x70, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x70, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b,
-	0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x70, 0x52, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x73, 0x22, 0x46, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x23, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
-	0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72,
-	0x05, 0x10, 0x02, 0x18, 0xc8, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2c, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72,
-	0x65, 0x70, 0x6c, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65,
-	0x70, 0x6c, 0x79, 0x49, 0x44, 0x22, 0xdf, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
-	0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x49, 0x44, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x02, 0x18, 0xc8,
-	0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72,
-	0x05, 0x10, 0x02, 0x18, 0xc8, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2f, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x65, 0x61,
-	0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08,
-	0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x49, 0x44, 0x22, 0xd1, 0x01, 0x0a, 0x12, 0x41, 0x75, 0x64,
-	0x69, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x23, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x61, 0x70, 0x70, 0x65,
-	0x61, 0x6c, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
-	0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02,
-	0x20, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x70,
-	0x55, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x10, 0x02, 0x52, 0x06, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x09, 0x6f,
-	0x70, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x09, 0x6f, 0x70, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c,
-	0x0a, 0x0a, 0x5f, 0x6f, 0x70, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x12, 0x0a, 0x10,
-	0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x76, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x79,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1b,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42,
-	0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02,
-	0x20, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x48, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69,
-	0x73, 0x74, 0x32, 0xac, 0x06, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x6b, 0x0a,
-	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a,
-	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x6a, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12,
-	0x15, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x7b, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x49, 0x44, 0x7d, 0x12, 0x6e, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x12, 0x6e, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x2f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x72, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61,
-	0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x6e, 0x0a, 0x0b, 0x41, 0x75,
-	0x64, 0x69, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41,
-	0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64,
-	0x69, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70,
-	0x70, 0x65, 0x61, 0x6c, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x12, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x79, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31,
-	0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x73, 0x42, 0x32, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e,
-	0x76, 0x31, 0x50, 0x01, 0x5a, 0x1f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2d, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f,
-	0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	TagID    int64 `protobuf:"varint,1,opt,name=tagID,proto3" json:"tagID,omitempty"`
+	StoreID  int64 `protobuf:"varint,2,opt,name=storeID,proto3" json:"storeID,omitempty"`
+	Status   int32 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	MinScore int32 `protobuf:"varint,4,opt,name=minScore,proto3" json:"minScore,omitempty"`
+	MaxScore int32 `protobuf:"varint,5,opt,name=maxScore,proto3" json:"maxScore,omitempty"`
+	// 操作人，写入运营操作日志
+	OpUser string `protobuf:"bytes,6,opt,name=opUser,proto3" json:"opUser,omitempty"`
 }
 
-var (
-	file_api_review_v1_review_proto_rawDescOnce sync.Once
-	file_api_review_v1_review_proto_rawDescData = file_api_review_v1_review_proto_rawDesc
-)
+func (x *BulkTagReviewsRequest) Reset() {
+	*x = BulkTagReviewsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_review_v1_review_proto_rawDescGZIP() []byte {
-	file_api_review_v1_review_proto_rawDescOnce.Do(func() {
-		file_api_review_v1_review_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_review_v1_review_proto_rawDescData)
-	})
-	return file_api_review_v1_review_proto_rawDescData
+func (x *BulkTagReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_review_v1_review_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_api_review_v1_review_proto_goTypes = []interface{}{
-	(*CreateReviewRequest)(nil),       // 0: api.review.v1.CreateReviewRequest
-	(*CreateReviewReply)(nil),         // 1: api.review.v1.CreateReviewReply
-	(*GetReviewRequest)(nil),          // 2: api.review.v1.GetReviewRequest
-	(*GetReviewReply)(nil),            // 3: api.review.v1.GetReviewReply
-	(*ReviewInfo)(nil),                // 4: api.review.v1.ReviewInfo
-	(*AuditReviewRequest)(nil),        // 5: api.review.v1.AuditReviewRequest
-	(*AuditReviewReply)(nil),          // 6: api.review.v1.AuditReviewReply
-	(*ReplyReviewRequest)(nil),        // 7: api.review.v1.ReplyReviewRequest
-	(*ReplyReviewReply)(nil),          // 8: api.review.v1.ReplyReviewReply
-	(*AppealReviewRequest)(nil),       // 9: api.review.v1.AppealReviewRequest
-	(*AppealReviewReply)(nil),         // 10: api.review.v1.AppealReviewReply
-	(*AuditAppealRequest)(nil),        // 11: api.review.v1.AuditAppealRequest
-	(*AuditAppealReply)(nil),          // 12: api.review.v1.AuditAppealReply
-	(*ListReviewByUserIDRequest)(nil), // 13: api.review.v1.ListReviewByUserIDRequest
-	(*ListReviewByUserIDReply)(nil),   // 14: api.review.v1.ListReviewByUserIDReply
+func (*BulkTagReviewsRequest) ProtoMessage() {}
+
+func (x *BulkTagReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_review_v1_review_proto_depIdxs = []int32{
-	4,  // 0: api.review.v1.GetReviewReply.data:type_name -> api.review.v1.ReviewInfo
-	4,  // 1: api.review.v1.ListReviewByUserIDReply.list:type_name -> api.review.v1.ReviewInfo
-	0,  // 2: api.review.v1.Review.CreateReview:input_type -> api.review.v1.CreateReviewRequest
-	2,  // 3: api.review.v1.Review.GetReview:input_type -> api.review.v1.GetReviewRequest
-	5,  // 4: api.review.v1.Review.AuditReview:input_type -> api.review.v1.AuditReviewRequest
-	7,  // 5: api.review.v1.Review.ReplyReview:input_type -> api.review.v1.ReplyReviewRequest
-	9,  // 6: api.review.v1.Review.AppealReview:input_type -> api.review.v1.AppealReviewRequest
-	11, // 7: api.review.v1.Review.AuditAppeal:input_type -> api.review.v1.AuditAppealRequest
-	13, // 8: api.review.v1.Review.ListReviewByUserID:input_type -> api.review.v1.ListReviewByUserIDRequest
-	1,  // 9: api.review.v1.Review.CreateReview:output_type -> api.review.v1.CreateReviewReply
-	3,  // 10: api.review.v1.Review.GetReview:output_type -> api.review.v1.GetReviewReply
-	6,  // 11: api.review.v1.Review.AuditReview:output_type -> api.review.v1.AuditReviewReply
-	8,  // 12: api.review.v1.Review.ReplyReview:output_type -> api.review.v1.ReplyReviewReply
-	10, // 13: api.review.v1.Review.AppealReview:output_type -> api.review.v1.AppealReviewReply
-	12, // 14: api.review.v1.Review.AuditAppeal:output_type -> api.review.v1.AuditAppealReply
-	14, // 15: api.review.v1.Review.ListReviewByUserID:output_type -> api.review.v1.ListReviewByUserIDReply
-	9,  // [9:16] is the sub-list for method output_type
-	2,  // [2:9] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+
+// Deprecated: Use BulkTagReviewsRequest.ProtoReflect.Descriptor instead.
+func (*BulkTagReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{15}
 }
 
-func init() { file_api_review_v1_review_proto_init() }
-func file_api_review_v1_review_proto_init() {
-	if File_api_review_v1_review_proto != nil {
-		return
+func (x *BulkTagReviewsRequest) GetTagID() int64 {
+	if x != nil {
+		return x.TagID
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_review_v1_review_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateReviewRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_review_v1_review_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateReviewReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+func (x *BulkTagReviewsRequest) GetStoreID() int64 {
+	if x != nil {
+		return x.StoreID
+	}
+	return 0
+}
+
+func (x *BulkTagReviewsRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *BulkTagReviewsRequest) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *BulkTagReviewsRequest) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *BulkTagReviewsRequest) GetOpUser() string {
+	if x != nil {
+		return x.OpUser
+	}
+	return ""
+}
+
+// 批量打标签的返回值
+type BulkTagReviewsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *BulkTagReviewsReply) Reset() {
+	*x = BulkTagReviewsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkTagReviewsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkTagReviewsReply) ProtoMessage() {}
+
+func (x *BulkTagReviewsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_review_v1_review_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetReviewRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkTagReviewsReply.ProtoReflect.Descriptor instead.
+func (*BulkTagReviewsReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BulkTagReviewsReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 申请撤回评价的请求
+type RequestWithdrawalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewID int64  `protobuf:"varint,1,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
+	UserID   int64  `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RequestWithdrawalRequest) Reset() {
+	*x = RequestWithdrawalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestWithdrawalRequest) ProtoMessage() {}
+
+func (x *RequestWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_review_v1_review_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetReviewReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*RequestWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RequestWithdrawalRequest) GetReviewID() int64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+func (x *RequestWithdrawalRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RequestWithdrawalRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 申请撤回评价的返回值
+type RequestWithdrawalReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WithdrawalID int64 `protobuf:"varint,1,opt,name=withdrawalID,proto3" json:"withdrawalID,omitempty"`
+	Status       int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RequestWithdrawalReply) Reset() {
+	*x = RequestWithdrawalReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestWithdrawalReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestWithdrawalReply) ProtoMessage() {}
+
+func (x *RequestWithdrawalReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_review_v1_review_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReviewInfo); i {
-			case 0:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestWithdrawalReply.ProtoReflect.Descriptor instead.
+func (*RequestWithdrawalReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RequestWithdrawalReply) GetWithdrawalID() int64 {
+	if x != nil {
+		return x.WithdrawalID
+	}
+	return 0
+}
+
+func (x *RequestWithdrawalReply) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 审核撤回申请的请求
+type AuditWithdrawalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WithdrawalID int64   `protobuf:"varint,1,opt,name=withdrawalID,proto3" json:"withdrawalID,omitempty"`
+	OpUser       string  `protobuf:"bytes,2,opt,name=opUser,proto3" json:"opUser,omitempty"`
+	OpRemarks    *string `protobuf:"bytes,3,opt,name=opRemarks,proto3,oneof" json:"opRemarks,omitempty"`
+}
+
+func (x *AuditWithdrawalRequest) Reset() {
+	*x = AuditWithdrawalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditWithdrawalRequest) ProtoMessage() {}
+
+func (x *AuditWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*AuditWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AuditWithdrawalRequest) GetWithdrawalID() int64 {
+	if x != nil {
+		return x.WithdrawalID
+	}
+	return 0
+}
+
+func (x *AuditWithdrawalRequest) GetOpUser() string {
+	if x != nil {
+		return x.OpUser
+	}
+	return ""
+}
+
+func (x *AuditWithdrawalRequest) GetOpRemarks() string {
+	if x != nil && x.OpRemarks != nil {
+		return *x.OpRemarks
+	}
+	return ""
+}
+
+// 审核撤回申请的返回值
+type AuditWithdrawalReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WithdrawalID int64 `protobuf:"varint,1,opt,name=withdrawalID,proto3" json:"withdrawalID,omitempty"`
+	Status       int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *AuditWithdrawalReply) Reset() {
+	*x = AuditWithdrawalReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditWithdrawalReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditWithdrawalReply) ProtoMessage() {}
+
+func (x *AuditWithdrawalReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditWithdrawalReply.ProtoReflect.Descriptor instead.
+func (*AuditWithdrawalReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AuditWithdrawalReply) GetWithdrawalID() int64 {
+	if x != nil {
+		return x.WithdrawalID
+	}
+	return 0
+}
+
+func (x *AuditWithdrawalReply) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 取出待审核评价的请求
+type DequeueModerationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoreID int64 `protobuf:"varint,1,opt,name=storeID,proto3" json:"storeID,omitempty"`
+}
+
+func (x *DequeueModerationRequest) Reset() {
+	*x = DequeueModerationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DequeueModerationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DequeueModerationRequest) ProtoMessage() {}
+
+func (x *DequeueModerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DequeueModerationRequest.ProtoReflect.Descriptor instead.
+func (*DequeueModerationRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DequeueModerationRequest) GetStoreID() int64 {
+	if x != nil {
+		return x.StoreID
+	}
+	return 0
+}
+
+// 取出待审核评价的返回值，队列为空时reviewID为0
+type DequeueModerationReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewID int64 `protobuf:"varint,1,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
+}
+
+func (x *DequeueModerationReply) Reset() {
+	*x = DequeueModerationReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DequeueModerationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DequeueModerationReply) ProtoMessage() {}
+
+func (x *DequeueModerationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DequeueModerationReply.ProtoReflect.Descriptor instead.
+func (*DequeueModerationReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DequeueModerationReply) GetReviewID() int64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+// 查看待审核队列长度的请求
+type GetModerationQueueDepthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoreID int64 `protobuf:"varint,1,opt,name=storeID,proto3" json:"storeID,omitempty"`
+}
+
+func (x *GetModerationQueueDepthRequest) Reset() {
+	*x = GetModerationQueueDepthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModerationQueueDepthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationQueueDepthRequest) ProtoMessage() {}
+
+func (x *GetModerationQueueDepthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationQueueDepthRequest.ProtoReflect.Descriptor instead.
+func (*GetModerationQueueDepthRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetModerationQueueDepthRequest) GetStoreID() int64 {
+	if x != nil {
+		return x.StoreID
+	}
+	return 0
+}
+
+// 查看待审核队列长度的返回值
+type GetModerationQueueDepthReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Depth int64 `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (x *GetModerationQueueDepthReply) Reset() {
+	*x = GetModerationQueueDepthReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModerationQueueDepthReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationQueueDepthReply) ProtoMessage() {}
+
+func (x *GetModerationQueueDepthReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationQueueDepthReply.ProtoReflect.Descriptor instead.
+func (*GetModerationQueueDepthReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetModerationQueueDepthReply) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// 批量获取商品评价统计的请求，一次最多200个商品
+type BulkGetReviewStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductIDs []int64 `protobuf:"varint,1,rep,packed,name=productIDs,proto3" json:"productIDs,omitempty"`
+}
+
+func (x *BulkGetReviewStatsRequest) Reset() {
+	*x = BulkGetReviewStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkGetReviewStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkGetReviewStatsRequest) ProtoMessage() {}
+
+func (x *BulkGetReviewStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkGetReviewStatsRequest.ProtoReflect.Descriptor instead.
+func (*BulkGetReviewStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BulkGetReviewStatsRequest) GetProductIDs() []int64 {
+	if x != nil {
+		return x.ProductIDs
+	}
+	return nil
+}
+
+// 商品的评价统计，只统计审核通过的评价
+type ProductStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AvgScore            float64 `protobuf:"fixed64,1,opt,name=avgScore,proto3" json:"avgScore,omitempty"`
+	ReviewCount         int64   `protobuf:"varint,2,opt,name=reviewCount,proto3" json:"reviewCount,omitempty"`
+	HasVerifiedPurchase bool    `protobuf:"varint,3,opt,name=hasVerifiedPurchase,proto3" json:"hasVerifiedPurchase,omitempty"`
+}
+
+func (x *ProductStat) Reset() {
+	*x = ProductStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStat) ProtoMessage() {}
+
+func (x *ProductStat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStat.ProtoReflect.Descriptor instead.
+func (*ProductStat) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProductStat) GetAvgScore() float64 {
+	if x != nil {
+		return x.AvgScore
+	}
+	return 0
+}
+
+func (x *ProductStat) GetReviewCount() int64 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *ProductStat) GetHasVerifiedPurchase() bool {
+	if x != nil {
+		return x.HasVerifiedPurchase
+	}
+	return false
+}
+
+// 批量获取商品评价统计的返回值，key为商品id，没有评价的商品返回零值
+type BulkGetReviewStatsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats map[int64]*ProductStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BulkGetReviewStatsReply) Reset() {
+	*x = BulkGetReviewStatsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkGetReviewStatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkGetReviewStatsReply) ProtoMessage() {}
+
+func (x *BulkGetReviewStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkGetReviewStatsReply.ProtoReflect.Descriptor instead.
+func (*BulkGetReviewStatsReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BulkGetReviewStatsReply) GetStats() map[int64]*ProductStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// 获取全平台评价统计的请求，统计区间为[from, to)，单位秒
+type GetPlatformReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From int64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *GetPlatformReportRequest) Reset() {
+	*x = GetPlatformReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlatformReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformReportRequest) ProtoMessage() {}
+
+func (x *GetPlatformReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformReportRequest.ProtoReflect.Descriptor instead.
+func (*GetPlatformReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPlatformReportRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GetPlatformReportRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+// 全平台评价统计
+type GetPlatformReportReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalReviews int64   `protobuf:"varint,1,opt,name=totalReviews,proto3" json:"totalReviews,omitempty"`
+	AvgScore     float64 `protobuf:"fixed64,2,opt,name=avgScore,proto3" json:"avgScore,omitempty"`
+	// 审核通过数/已审核数
+	ApprovalRate    float64 `protobuf:"fixed64,3,opt,name=approvalRate,proto3" json:"approvalRate,omitempty"`
+	MostActiveStore int64   `protobuf:"varint,4,opt,name=mostActiveStore,proto3" json:"mostActiveStore,omitempty"`
+	// 每天评价的去重用户数的日均值
+	DauReviewers int64 `protobuf:"varint,5,opt,name=dauReviewers,proto3" json:"dauReviewers,omitempty"`
+	// 出现次数最多的评价关键词，从多到少
+	TopKeywords []string `protobuf:"bytes,6,rep,name=topKeywords,proto3" json:"topKeywords,omitempty"`
+}
+
+func (x *GetPlatformReportReply) Reset() {
+	*x = GetPlatformReportReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlatformReportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformReportReply) ProtoMessage() {}
+
+func (x *GetPlatformReportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformReportReply.ProtoReflect.Descriptor instead.
+func (*GetPlatformReportReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetPlatformReportReply) GetTotalReviews() int64 {
+	if x != nil {
+		return x.TotalReviews
+	}
+	return 0
+}
+
+func (x *GetPlatformReportReply) GetAvgScore() float64 {
+	if x != nil {
+		return x.AvgScore
+	}
+	return 0
+}
+
+func (x *GetPlatformReportReply) GetApprovalRate() float64 {
+	if x != nil {
+		return x.ApprovalRate
+	}
+	return 0
+}
+
+func (x *GetPlatformReportReply) GetMostActiveStore() int64 {
+	if x != nil {
+		return x.MostActiveStore
+	}
+	return 0
+}
+
+func (x *GetPlatformReportReply) GetDauReviewers() int64 {
+	if x != nil {
+		return x.DauReviewers
+	}
+	return 0
+}
+
+func (x *GetPlatformReportReply) GetTopKeywords() []string {
+	if x != nil {
+		return x.TopKeywords
+	}
+	return nil
+}
+
+// 查询异常评价的请求
+type ListAnomalousReviewsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoreID int64 `protobuf:"varint,1,opt,name=storeID,proto3" json:"storeID,omitempty"`
+	// 异常分数阈值，取值[0,1]
+	Threshold float64 `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *ListAnomalousReviewsRequest) Reset() {
+	*x = ListAnomalousReviewsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAnomalousReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnomalousReviewsRequest) ProtoMessage() {}
+
+func (x *ListAnomalousReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnomalousReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnomalousReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListAnomalousReviewsRequest) GetStoreID() int64 {
+	if x != nil {
+		return x.StoreID
+	}
+	return 0
+}
+
+func (x *ListAnomalousReviewsRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// 查询异常评价的返回值，按异常分数从高到低，最多100条
+type ListAnomalousReviewsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*ReviewInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *ListAnomalousReviewsReply) Reset() {
+	*x = ListAnomalousReviewsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAnomalousReviewsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnomalousReviewsReply) ProtoMessage() {}
+
+func (x *ListAnomalousReviewsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnomalousReviewsReply.ProtoReflect.Descriptor instead.
+func (*ListAnomalousReviewsReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListAnomalousReviewsReply) GetList() []*ReviewInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 时间段[from, to)，单位秒
+type DateRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From int64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *DateRange) Reset() {
+	*x = DateRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DateRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRange) ProtoMessage() {}
+
+func (x *DateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRange.ProtoReflect.Descriptor instead.
+func (*DateRange) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DateRange) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *DateRange) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+// 对比两个时间段评价数据的请求
+type CompareReviewPeriodsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoreID int64      `protobuf:"varint,1,opt,name=storeID,proto3" json:"storeID,omitempty"`
+	PeriodA *DateRange `protobuf:"bytes,2,opt,name=periodA,proto3" json:"periodA,omitempty"`
+	PeriodB *DateRange `protobuf:"bytes,3,opt,name=periodB,proto3" json:"periodB,omitempty"`
+}
+
+func (x *CompareReviewPeriodsRequest) Reset() {
+	*x = CompareReviewPeriodsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareReviewPeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareReviewPeriodsRequest) ProtoMessage() {}
+
+func (x *CompareReviewPeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareReviewPeriodsRequest.ProtoReflect.Descriptor instead.
+func (*CompareReviewPeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CompareReviewPeriodsRequest) GetStoreID() int64 {
+	if x != nil {
+		return x.StoreID
+	}
+	return 0
+}
+
+func (x *CompareReviewPeriodsRequest) GetPeriodA() *DateRange {
+	if x != nil {
+		return x.PeriodA
+	}
+	return nil
+}
+
+func (x *CompareReviewPeriodsRequest) GetPeriodB() *DateRange {
+	if x != nil {
+		return x.PeriodB
+	}
+	return nil
+}
+
+// 一个时间段内的评价统计
+type PeriodStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AvgScore    float64 `protobuf:"fixed64,1,opt,name=avgScore,proto3" json:"avgScore,omitempty"`
+	ReviewCount int64   `protobuf:"varint,2,opt,name=reviewCount,proto3" json:"reviewCount,omitempty"`
+}
+
+func (x *PeriodStats) Reset() {
+	*x = PeriodStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeriodStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodStats) ProtoMessage() {}
+
+func (x *PeriodStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodStats.ProtoReflect.Descriptor instead.
+func (*PeriodStats) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PeriodStats) GetAvgScore() float64 {
+	if x != nil {
+		return x.AvgScore
+	}
+	return 0
+}
+
+func (x *PeriodStats) GetReviewCount() int64 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+// 对比两个时间段评价数据的返回值
+type CompareReviewPeriodsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeriodA *PeriodStats `protobuf:"bytes,1,opt,name=periodA,proto3" json:"periodA,omitempty"`
+	PeriodB *PeriodStats `protobuf:"bytes,2,opt,name=periodB,proto3" json:"periodB,omitempty"`
+}
+
+func (x *CompareReviewPeriodsReply) Reset() {
+	*x = CompareReviewPeriodsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareReviewPeriodsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareReviewPeriodsReply) ProtoMessage() {}
+
+func (x *CompareReviewPeriodsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareReviewPeriodsReply.ProtoReflect.Descriptor instead.
+func (*CompareReviewPeriodsReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CompareReviewPeriodsReply) GetPeriodA() *PeriodStats {
+	if x != nil {
+		return x.PeriodA
+	}
+	return nil
+}
+
+func (x *CompareReviewPeriodsReply) GetPeriodB() *PeriodStats {
+	if x != nil {
+		return x.PeriodB
+	}
+	return nil
+}
+
+// 导出用户个人数据的请求
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExportUserDataRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+// 导出用户个人数据的返回值
+type ExportUserDataReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// JSON格式的个人数据
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// data的HMAC-SHA256签名，十六进制
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ExportUserDataReply) Reset() {
+	*x = ExportUserDataReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserDataReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataReply) ProtoMessage() {}
+
+func (x *ExportUserDataReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataReply.ProtoReflect.Descriptor instead.
+func (*ExportUserDataReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExportUserDataReply) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportUserDataReply) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// 查询热门标签的请求
+type DetectTrendingTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoreID int64 `protobuf:"varint,1,opt,name=storeID,proto3" json:"storeID,omitempty"`
+	TopN    int32 `protobuf:"varint,2,opt,name=topN,proto3" json:"topN,omitempty"`
+}
+
+func (x *DetectTrendingTagsRequest) Reset() {
+	*x = DetectTrendingTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectTrendingTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectTrendingTagsRequest) ProtoMessage() {}
+
+func (x *DetectTrendingTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectTrendingTagsRequest.ProtoReflect.Descriptor instead.
+func (*DetectTrendingTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DetectTrendingTagsRequest) GetStoreID() int64 {
+	if x != nil {
+		return x.StoreID
+	}
+	return 0
+}
+
+func (x *DetectTrendingTagsRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+// 热门标签，trendScore = (todayCount - weeklyAvg) / max(weeklyAvg, 1)
+type TrendingTag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TagID      int64   `protobuf:"varint,1,opt,name=tagID,proto3" json:"tagID,omitempty"`
+	Name       string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TodayCount int64   `protobuf:"varint,3,opt,name=todayCount,proto3" json:"todayCount,omitempty"`
+	WeeklyAvg  float64 `protobuf:"fixed64,4,opt,name=weeklyAvg,proto3" json:"weeklyAvg,omitempty"`
+	TrendScore float64 `protobuf:"fixed64,5,opt,name=trendScore,proto3" json:"trendScore,omitempty"`
+}
+
+func (x *TrendingTag) Reset() {
+	*x = TrendingTag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrendingTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingTag) ProtoMessage() {}
+
+func (x *TrendingTag) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingTag.ProtoReflect.Descriptor instead.
+func (*TrendingTag) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TrendingTag) GetTagID() int64 {
+	if x != nil {
+		return x.TagID
+	}
+	return 0
+}
+
+func (x *TrendingTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrendingTag) GetTodayCount() int64 {
+	if x != nil {
+		return x.TodayCount
+	}
+	return 0
+}
+
+func (x *TrendingTag) GetWeeklyAvg() float64 {
+	if x != nil {
+		return x.WeeklyAvg
+	}
+	return 0
+}
+
+func (x *TrendingTag) GetTrendScore() float64 {
+	if x != nil {
+		return x.TrendScore
+	}
+	return 0
+}
+
+// 查询热门标签的返回值，按trendScore从高到低
+type DetectTrendingTagsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*TrendingTag `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *DetectTrendingTagsReply) Reset() {
+	*x = DetectTrendingTagsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectTrendingTagsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectTrendingTagsReply) ProtoMessage() {}
+
+func (x *DetectTrendingTagsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectTrendingTagsReply.ProtoReflect.Descriptor instead.
+func (*DetectTrendingTagsReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DetectTrendingTagsReply) GetList() []*TrendingTag {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 开始评价会话的请求，订单和商品信息在开始时确定
+type StartReviewSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	OrderID int64 `protobuf:"varint,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	StoreID int64 `protobuf:"varint,3,opt,name=storeID,proto3" json:"storeID,omitempty"`
+	SpuID   int64 `protobuf:"varint,4,opt,name=spuID,proto3" json:"spuID,omitempty"`
+	SkuID   int64 `protobuf:"varint,5,opt,name=skuID,proto3" json:"skuID,omitempty"`
+}
+
+func (x *StartReviewSessionRequest) Reset() {
+	*x = StartReviewSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartReviewSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReviewSessionRequest) ProtoMessage() {}
+
+func (x *StartReviewSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartReviewSessionRequest.ProtoReflect.Descriptor instead.
+func (*StartReviewSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *StartReviewSessionRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *StartReviewSessionRequest) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+func (x *StartReviewSessionRequest) GetStoreID() int64 {
+	if x != nil {
+		return x.StoreID
+	}
+	return 0
+}
+
+func (x *StartReviewSessionRequest) GetSpuID() int64 {
+	if x != nil {
+		return x.SpuID
+	}
+	return 0
+}
+
+func (x *StartReviewSessionRequest) GetSkuID() int64 {
+	if x != nil {
+		return x.SkuID
+	}
+	return 0
+}
+
+// 开始评价会话的返回值
+type StartReviewSessionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *StartReviewSessionReply) Reset() {
+	*x = StartReviewSessionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartReviewSessionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReviewSessionReply) ProtoMessage() {}
+
+func (x *StartReviewSessionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartReviewSessionReply.ProtoReflect.Descriptor instead.
+func (*StartReviewSessionReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StartReviewSessionReply) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+// 保存评价会话某一步数据的请求
+type UpdateReviewSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	UserID    int64  `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	// 步骤：1评分、2文字、3图片
+	Step int32 `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	// 这一步填写的数据，字段名与CreateReviewRequest一致
+	Data *structpb.Struct `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UpdateReviewSessionRequest) Reset() {
+	*x = UpdateReviewSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateReviewSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewSessionRequest) ProtoMessage() {}
+
+func (x *UpdateReviewSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewSessionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReviewSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateReviewSessionRequest) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *UpdateReviewSessionRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *UpdateReviewSessionRequest) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *UpdateReviewSessionRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 保存评价会话某一步数据的返回值
+type UpdateReviewSessionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateReviewSessionReply) Reset() {
+	*x = UpdateReviewSessionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateReviewSessionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewSessionReply) ProtoMessage() {}
+
+func (x *UpdateReviewSessionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewSessionReply.ProtoReflect.Descriptor instead.
+func (*UpdateReviewSessionReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{44}
+}
+
+// 提交评价会话的请求
+type CommitReviewSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	UserID    int64  `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *CommitReviewSessionRequest) Reset() {
+	*x = CommitReviewSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitReviewSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReviewSessionRequest) ProtoMessage() {}
+
+func (x *CommitReviewSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReviewSessionRequest.ProtoReflect.Descriptor instead.
+func (*CommitReviewSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CommitReviewSessionRequest) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *CommitReviewSessionRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+// 提交评价会话的返回值
+type CommitReviewSessionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewID int64 `protobuf:"varint,1,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
+}
+
+func (x *CommitReviewSessionReply) Reset() {
+	*x = CommitReviewSessionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitReviewSessionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReviewSessionReply) ProtoMessage() {}
+
+func (x *CommitReviewSessionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReviewSessionReply.ProtoReflect.Descriptor instead.
+func (*CommitReviewSessionReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CommitReviewSessionReply) GetReviewID() int64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+// 店铺评价列表的请求参数，status为0时不限制状态
+type ListReviewByStoreIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoreID int64 `protobuf:"varint,1,opt,name=storeID,proto3" json:"storeID,omitempty"`
+	Status  int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Page    int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Size    int32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListReviewByStoreIDRequest) Reset() {
+	*x = ListReviewByStoreIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReviewByStoreIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewByStoreIDRequest) ProtoMessage() {}
+
+func (x *ListReviewByStoreIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewByStoreIDRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewByStoreIDRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListReviewByStoreIDRequest) GetStoreID() int64 {
+	if x != nil {
+		return x.StoreID
+	}
+	return 0
+}
+
+func (x *ListReviewByStoreIDRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListReviewByStoreIDRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReviewByStoreIDRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 店铺评价列表的返回值
+type ListReviewByStoreIDReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*ReviewInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *ListReviewByStoreIDReply) Reset() {
+	*x = ListReviewByStoreIDReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_review_v1_review_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReviewByStoreIDReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewByStoreIDReply) ProtoMessage() {}
+
+func (x *ListReviewByStoreIDReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewByStoreIDReply.ProtoReflect.Descriptor instead.
+func (*ListReviewByStoreIDReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListReviewByStoreIDReply) GetList() []*ReviewInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+var File_api_review_v1_review_proto protoreflect.FileDescriptor
+
+var file_api_review_v1_review_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x61, 0x70,
+	0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xc7, 0x03, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x07,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x12,
+	0x25, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0f,
+	0xfa, 0x42, 0x0c, 0x1a, 0x0a, 0x30, 0x01, 0x30, 0x02, 0x30, 0x03, 0x30, 0x04, 0x30, 0x05, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0f, 0xfa, 0x42,
+	0x0c, 0x1a, 0x0a, 0x30, 0x01, 0x30, 0x02, 0x30, 0x03, 0x30, 0x04, 0x30, 0x05, 0x52, 0x0c, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0f, 0xfa, 0x42, 0x0c, 0x1a, 0x0a, 0x30, 0x01, 0x30, 0x02, 0x30, 0x03, 0x30, 0x04,
+	0x30, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x08, 0x18, 0xff, 0x01, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1c, 0x0a, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x05,
+	0x73, 0x70, 0x75, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x22, 0x02, 0x20, 0x00, 0x52, 0x05, 0x73, 0x70, 0x75, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x05, 0x73,
+	0x6b, 0x75, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x52, 0x05, 0x73, 0x6b, 0x75, 0x49, 0x44, 0x22, 0x2f, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x37, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x44, 0x22, 0x3f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8a, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x69,
+	0x63, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x69, 0x63,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x22, 0xd3, 0x01, 0x0a, 0x12, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12, 0x21,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09,
+	0xfa, 0x42, 0x06, 0x1a, 0x04, 0x30, 0x14, 0x30, 0x1e, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x02, 0x52, 0x06, 0x6f, 0x70, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x08, 0x6f, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x02, 0x52, 0x08, 0x6f,
+	0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x09, 0x6f, 0x70, 0x52, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x70,
+	0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f,
+	0x70, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x46, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xba, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x07,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x12,
+	0x24, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x02, 0x18, 0xc8, 0x01, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1c, 0x0a, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2c, 0x0a,
+	0x10, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x44, 0x22, 0xdf, 0x01, 0x0a, 0x13,
+	0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07,
+	0x72, 0x05, 0x10, 0x02, 0x18, 0xc8, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x24, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x02, 0x18, 0xc8, 0x01, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1c, 0x0a, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2f, 0x0a,
+	0x11, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x49, 0x44, 0x22, 0xd1,
+	0x01, 0x0a, 0x12, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x08, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12,
+	0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x02, 0x52, 0x06, 0x6f, 0x70, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x21, 0x0a, 0x09, 0x6f, 0x70, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x70, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x70, 0x52, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x76, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x1b, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x48,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x15, 0x42, 0x75, 0x6c,
+	0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x05, 0x74, 0x61, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x05, 0x74, 0x61, 0x67, 0x49,
+	0x44, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x07, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x05,
+	0x28, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09,
+	0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x05, 0x28, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x02, 0x52, 0x06, 0x6f, 0x70,
+	0x55, 0x73, 0x65, 0x72, 0x22, 0x2b, 0x0a, 0x13, 0x42, 0x75, 0x6c, 0x6b, 0x54, 0x61, 0x67, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x84, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x02, 0x18, 0xc8, 0x01,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x97,
+	0x01, 0x0a, 0x16, 0x41, 0x75, 0x64, 0x69, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x02, 0x52,
+	0x06, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x09, 0x6f, 0x70, 0x52, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x70,
+	0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f,
+	0x70, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x52, 0x0a, 0x14, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3d, 0x0a, 0x18,
+	0x44, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x22, 0x34, 0x0a, 0x16, 0x44,
+	0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49,
+	0x44, 0x22, 0x43, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x22, 0x34, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74,
+	0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0x4e, 0x0a, 0x19,
+	0x42, 0x75, 0x6c, 0x6b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x11, 0xfa,
+	0x42, 0x0e, 0x92, 0x01, 0x0b, 0x08, 0x01, 0x10, 0xc8, 0x01, 0x22, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x73, 0x22, 0x7d, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x76, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61,
+	0x76, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x68, 0x61, 0x73,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x68, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x17,
+	0x42, 0x75, 0x6c, 0x6b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x47, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x1a, 0x54, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x17, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xec, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x76, 0x67, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x76, 0x67, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x6f, 0x73, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x6d, 0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x61, 0x75, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x61, 0x75, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x4b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x4b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x77, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42,
+	0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x29, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x22, 0x4a, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x6f, 0x75,
+	0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x09,
+	0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x74, 0x6f, 0x22,
+	0xbc, 0x01, 0x0a, 0x1b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x49, 0x44, 0x12, 0x3c, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41,
+	0x12, 0x3c, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x22, 0x4b,
+	0x0a, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x76, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x61, 0x76, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x19,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x41, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41, 0x12,
+	0x34, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x42, 0x22, 0x38, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0x47, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x5d, 0x0a, 0x19, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x04, 0x74, 0x6f, 0x70, 0x4e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28,
+	0x01, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4e, 0x22, 0x95, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x67, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x61, 0x67, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x41, 0x76, 0x67, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x41, 0x76, 0x67, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22,
+	0x49, 0x0a, 0x17, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x54, 0x61, 0x67, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x19, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x07,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x12,
+	0x1d, 0x0a, 0x05, 0x73, 0x70, 0x75, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x05, 0x73, 0x70, 0x75, 0x49, 0x44, 0x12, 0x1d,
+	0x0a, 0x05, 0x73, 0x6b, 0x75, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x05, 0x73, 0x6b, 0x75, 0x49, 0x44, 0x22, 0x37, 0x0a,
+	0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xb2, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1f, 0x0a,
+	0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xfa, 0x42, 0x08,
+	0x1a, 0x06, 0x30, 0x01, 0x30, 0x02, 0x30, 0x03, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x2b,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x1a, 0x0a, 0x18, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x64, 0x0a, 0x1a, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x36, 0x0a,
+	0x18, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x9c, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x42, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x20, 0x00, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x49, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x42, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x32,
+	0xf6, 0x35, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0xc8, 0x01, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x72, 0x92, 0x41, 0x5a, 0x0a, 0x04, 0x43, 0xe7, 0xab, 0xaf, 0x12, 0x0c, 0xe5, 0x88,
+	0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0x4a, 0x44, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x3d, 0x0a, 0x02, 0x4f, 0x4b, 0x22, 0x37, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x7b, 0x22, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x36, 0x32, 0x30, 0x30,
+	0x36, 0x33, 0x39, 0x37, 0x36, 0x35, 0x38, 0x33, 0x35, 0x34, 0x38, 0x39, 0x32, 0x38, 0x22, 0x7d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0xe1, 0x03, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x93, 0x03, 0x92, 0x41, 0xf2, 0x02, 0x0a, 0x04, 0x43, 0xe7, 0xab, 0xaf,
+	0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0xe8, 0xaf,
+	0xa6, 0xe6, 0x83, 0x85, 0x4a, 0xd5, 0x02, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0xcd, 0x02, 0x0a,
+	0x02, 0x4f, 0x4b, 0x22, 0xc6, 0x02, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0xb1, 0x02, 0x7b, 0x22, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x3a,
+	0x20, 0x22, 0x31, 0x36, 0x32, 0x30, 0x30, 0x36, 0x33, 0x39, 0x37, 0x36, 0x35, 0x38, 0x33, 0x35,
+	0x34, 0x38, 0x39, 0x32, 0x38, 0x22, 0x2c, 0x20, 0x22, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0x3a, 0x20, 0x22, 0x31, 0x30, 0x30, 0x30, 0x31, 0x22, 0x2c, 0x20, 0x22, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x32, 0x30, 0x30, 0x30, 0x31, 0x22, 0x2c, 0x20, 0x22,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x35, 0x2c, 0x20, 0x22, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x35, 0x2c, 0x20, 0x22, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x2c,
+	0x20, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x3a, 0x20, 0x22, 0xe7, 0x89, 0xa9,
+	0xe6, 0xb5, 0x81, 0xe5, 0xbe, 0x88, 0xe5, 0xbf, 0xab, 0xef, 0xbc, 0x8c, 0xe5, 0x8c, 0x85, 0xe8,
+	0xa3, 0x85, 0xe5, 0xae, 0x8c, 0xe5, 0xa5, 0xbd, 0x22, 0x2c, 0x20, 0x22, 0x70, 0x69, 0x63, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x3a, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3a, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x3a, 0x20, 0x32, 0x30, 0x2c, 0x20, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x34, 0x38, 0x30, 0x2c, 0x20,
+	0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x32, 0x30, 0x32,
+	0x33, 0x2d, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x54, 0x32, 0x30, 0x3a, 0x33, 0x30, 0x3a, 0x30, 0x30,
+	0x2b, 0x30, 0x38, 0x3a, 0x30, 0x30, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c,
+	0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x30, 0x7d, 0x7d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x7b,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x7d, 0x12, 0xda, 0x01, 0x0a, 0x0b, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x86, 0x01,
+	0x92, 0x41, 0x68, 0x0a, 0x04, 0x4f, 0xe7, 0xab, 0xaf, 0x12, 0x0c, 0xe5, 0xae, 0xa1, 0xe6, 0xa0,
+	0xb8, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0x4a, 0x52, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x4b,
+	0x0a, 0x02, 0x4f, 0x4b, 0x22, 0x45, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x31, 0x7b, 0x22, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x36, 0x32, 0x30, 0x30, 0x36, 0x33, 0x39,
+	0x37, 0x36, 0x35, 0x38, 0x33, 0x35, 0x34, 0x38, 0x39, 0x32, 0x38, 0x22, 0x2c, 0x20, 0x22, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x20, 0x32, 0x30, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x12, 0xca, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x77, 0x92, 0x41, 0x59, 0x0a,
+	0x04, 0x42, 0xe7, 0xab, 0xaf, 0x12, 0x0c, 0xe5, 0x9b, 0x9e, 0xe5, 0xa4, 0x8d, 0xe8, 0xaf, 0x84,
+	0xe4, 0xbb, 0xb7, 0x4a, 0x43, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x3c, 0x0a, 0x02, 0x4f, 0x4b,
+	0x22, 0x36, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x7b, 0x22, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x44, 0x22,
+	0x3a, 0x20, 0x22, 0x31, 0x36, 0x32, 0x30, 0x30, 0x36, 0x34, 0x35, 0x32, 0x31, 0x33, 0x39, 0x38,
+	0x34, 0x37, 0x32, 0x37, 0x30, 0x34, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01,
+	0x2a, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0xcf, 0x01, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x79, 0x92, 0x41, 0x5a, 0x0a,
+	0x04, 0x42, 0xe7, 0xab, 0xaf, 0x12, 0x0c, 0xe7, 0x94, 0xb3, 0xe8, 0xaf, 0x89, 0xe8, 0xaf, 0x84,
+	0xe4, 0xbb, 0xb7, 0x4a, 0x44, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x3d, 0x0a, 0x02, 0x4f, 0x4b,
+	0x22, 0x37, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x7b, 0x22, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x49, 0x44,
+	0x22, 0x3a, 0x20, 0x22, 0x31, 0x36, 0x32, 0x30, 0x30, 0x36, 0x34, 0x38, 0x37, 0x33, 0x36, 0x35,
+	0x32, 0x38, 0x39, 0x39, 0x38, 0x34, 0x30, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a,
+	0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x61,
+	0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0xb0, 0x01, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41,
+	0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x5d, 0x92, 0x41, 0x3f, 0x0a, 0x04,
+	0x4f, 0xe7, 0xab, 0xaf, 0x12, 0x12, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0xe7, 0x94, 0xb3, 0xe8,
+	0xaf, 0x89, 0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8, 0x4a, 0x23, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
+	0x1c, 0x0a, 0x02, 0x4f, 0x4b, 0x22, 0x16, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x02, 0x7b, 0x7d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x65,
+	0x61, 0x6c, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x12, 0x86, 0x04, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x9d, 0x03, 0x92, 0x41, 0xfd, 0x02, 0x0a, 0x04, 0x43, 0xe7, 0xab, 0xaf, 0x12, 0x1b,
+	0xe6, 0x9f, 0xa5, 0xe7, 0x9c, 0x8b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0xe4, 0xb8, 0x8b, 0xe6,
+	0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0x4a, 0xd7, 0x02, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0xcf, 0x02, 0x0a, 0x02, 0x4f, 0x4b, 0x22, 0xc8, 0x02, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0xb3, 0x02, 0x7b, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x5b, 0x7b, 0x22, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x36, 0x32, 0x30, 0x30, 0x36,
+	0x33, 0x39, 0x37, 0x36, 0x35, 0x38, 0x33, 0x35, 0x34, 0x38, 0x39, 0x32, 0x38, 0x22, 0x2c, 0x20,
+	0x22, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x30, 0x30, 0x30, 0x31,
+	0x22, 0x2c, 0x20, 0x22, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x32,
+	0x30, 0x30, 0x30, 0x31, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a, 0x20,
+	0x35, 0x2c, 0x20, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0x3a, 0x20, 0x35, 0x2c, 0x20, 0x22, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x2c, 0x20, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x22, 0x3a, 0x20, 0x22, 0xe7, 0x89, 0xa9, 0xe6, 0xb5, 0x81, 0xe5, 0xbe, 0x88, 0xe5, 0xbf,
+	0xab, 0xef, 0xbc, 0x8c, 0xe5, 0x8c, 0x85, 0xe8, 0xa3, 0x85, 0xe5, 0xae, 0x8c, 0xe5, 0xa5, 0xbd,
+	0x22, 0x2c, 0x20, 0x22, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3a, 0x20, 0x22, 0x22,
+	0x2c, 0x20, 0x22, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3a, 0x20, 0x22,
+	0x22, 0x2c, 0x20, 0x22, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x20, 0x32, 0x30, 0x2c,
+	0x20, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x34, 0x38, 0x30, 0x2c, 0x20, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x74, 0x22, 0x3a, 0x20, 0x22, 0x32, 0x30, 0x32, 0x33, 0x2d, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x54,
+	0x32, 0x30, 0x3a, 0x33, 0x30, 0x3a, 0x30, 0x30, 0x2b, 0x30, 0x38, 0x3a, 0x30, 0x30, 0x22, 0x2c,
+	0x20, 0x22, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a,
+	0x20, 0x30, 0x7d, 0x5d, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31,
+	0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x12, 0xd8, 0x01, 0x0a, 0x0e, 0x42, 0x75, 0x6c, 0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x54,
+	0x61, 0x67, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x7c,
+	0x92, 0x41, 0x5b, 0x0a, 0x04, 0x4f, 0xe7, 0xab, 0xaf, 0x12, 0x21, 0xe6, 0x8c, 0x89, 0xe6, 0x9d,
+	0xa1, 0xe4, 0xbb, 0xb6, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe7, 0xbb, 0x99, 0xe8, 0xaf, 0x84,
+	0xe4, 0xbb, 0xb7, 0xe6, 0x89, 0x93, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x4a, 0x30, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x29, 0x0a, 0x02, 0x4f, 0x4b, 0x22, 0x23, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x0f, 0x7b,
+	0x22, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x32, 0x22, 0x7d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x2f, 0x74, 0x61, 0x67, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x12, 0x8e, 0x02, 0x0a,
+	0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0xa8, 0x01, 0x92, 0x41, 0x84, 0x01, 0x0a, 0x04, 0x43, 0xe7, 0xab, 0xaf, 0x12,
+	0x24, 0xe7, 0x94, 0xb3, 0xe8, 0xaf, 0xb7, 0xe6, 0x92, 0xa4, 0xe5, 0x9b, 0x9e, 0xe5, 0xb7, 0xb2,
+	0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe7, 0x9a, 0x84, 0xe8,
+	0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0x4a, 0x56, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x4f, 0x0a, 0x02,
+	0x4f, 0x4b, 0x22, 0x49, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x35, 0x7b, 0x22, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x36, 0x32, 0x30, 0x30, 0x36,
+	0x35, 0x32, 0x39, 0x30, 0x38, 0x37, 0x34, 0x38, 0x39, 0x36, 0x33, 0x38, 0x34, 0x22, 0x2c, 0x20,
+	0x22, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x7d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0xf8, 0x01,
+	0x0a, 0x11, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x96, 0x01, 0x92, 0x41, 0x72, 0x0a, 0x04, 0x4f, 0xe7, 0xab, 0xaf, 0x12, 0x12, 0xe5, 0x90, 0x8c,
+	0xe6, 0x84, 0x8f, 0xe6, 0x92, 0xa4, 0xe5, 0x9b, 0x9e, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0x4a,
+	0x56, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x4f, 0x0a, 0x02, 0x4f, 0x4b, 0x22, 0x49, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x35, 0x7b, 0x22, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x44,
+	0x22, 0x3a, 0x20, 0x22, 0x31, 0x36, 0x32, 0x30, 0x30, 0x36, 0x35, 0x32, 0x39, 0x30, 0x38, 0x37,
+	0x34, 0x38, 0x39, 0x36, 0x33, 0x38, 0x34, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x3a, 0x20, 0x32, 0x30, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a,
+	0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0xf2, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6e,
+	0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x25, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x93, 0x01, 0x92, 0x41, 0x72, 0x0a, 0x04, 0x4f,
+	0xe7, 0xab, 0xaf, 0x12, 0x12, 0xe6, 0x8b, 0x92, 0xe7, 0xbb, 0x9d, 0xe6, 0x92, 0xa4, 0xe5, 0x9b,
+	0x9e, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0x4a, 0x56, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x4f,
+	0x0a, 0x02, 0x4f, 0x4b, 0x22, 0x49, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x35, 0x7b, 0x22, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x36, 0x32, 0x30,
+	0x30, 0x36, 0x35, 0x32, 0x39, 0x30, 0x38, 0x37, 0x34, 0x38, 0x39, 0x36, 0x33, 0x38, 0x34, 0x22,
+	0x2c, 0x20, 0x22, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x20, 0x33, 0x30, 0x7d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x2f, 0x64, 0x65, 0x6e, 0x79, 0x12, 0x95, 0x02,
+	0x0a, 0x11, 0x44, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0xaf, 0x01, 0x92, 0x41, 0x8a, 0x01, 0x0a, 0x04, 0x4f, 0xe7, 0xab, 0xaf,
+	0x12, 0x3c, 0xe4, 0xbb, 0x8e, 0xe5, 0xba, 0x97, 0xe9, 0x93, 0xba, 0xe7, 0x9a, 0x84, 0xe5, 0xbe,
+	0x85, 0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8, 0xe9, 0x98, 0x9f, 0xe5, 0x88, 0x97, 0xe4, 0xb8, 0xad,
+	0xe5, 0x8f, 0x96, 0xe5, 0x87, 0xba, 0xe6, 0x9c, 0x80, 0xe7, 0xb4, 0xa7, 0xe6, 0x80, 0xa5, 0xe7,
+	0x9a, 0x84, 0xe4, 0xb8, 0x80, 0xe6, 0x9d, 0xa1, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0x4a, 0x44,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x3d, 0x0a, 0x02, 0x4f, 0x4b, 0x22, 0x37, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x23, 0x7b, 0x22, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x31,
+	0x36, 0x32, 0x30, 0x30, 0x36, 0x33, 0x39, 0x37, 0x36, 0x35, 0x38, 0x33, 0x35, 0x34, 0x38, 0x39,
+	0x32, 0x38, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x64, 0x65,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0xfe, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74,
+	0x68, 0x12, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x86, 0x01,
+	0x92, 0x41, 0x5d, 0x0a, 0x04, 0x4f, 0xe7, 0xab, 0xaf, 0x12, 0x24, 0xe6, 0x9f, 0xa5, 0xe7, 0x9c,
+	0x8b, 0xe5, 0xba, 0x97, 0xe9, 0x93, 0xba, 0xe5, 0xbe, 0x85, 0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8,
+	0xe9, 0x98, 0x9f, 0xe5, 0x88, 0x97, 0xe7, 0x9a, 0x84, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0x4a,
+	0x2f, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x28, 0x0a, 0x02, 0x4f, 0x4b, 0x22, 0x22, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x0e, 0x7b, 0x22, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0x3a, 0x20, 0x22, 0x33, 0x22, 0x7d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x2f, 0x7b, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x49, 0x44, 0x7d, 0x12, 0xb3, 0x02, 0x0a, 0x12, 0x42, 0x75, 0x6c, 0x6b, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x28, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x6c, 0x6b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0xca, 0x01, 0x92, 0x41, 0xa6, 0x01, 0x0a, 0x04, 0x43, 0xe7, 0xab, 0xaf, 0x12, 0x21, 0xe6, 0x89,
+	0xb9, 0xe9, 0x87, 0x8f, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0x95, 0x86, 0xe5, 0x93, 0x81,
+	0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0x4a,
+	0x7b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x74, 0x0a, 0x02, 0x4f, 0x4b, 0x22, 0x6e, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x5a, 0x7b, 0x22, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x33, 0x30,
+	0x30, 0x30, 0x31, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x61, 0x76, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0x3a, 0x20, 0x34, 0x2e, 0x36, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x32, 0x38, 0x22, 0x2c, 0x20, 0x22, 0x68,
+	0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61,
+	0x73, 0x65, 0x22, 0x3a, 0x20, 0x74, 0x72, 0x75, 0x65, 0x7d, 0x7d, 0x7d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x12, 0xfc, 0x02, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x96, 0x02, 0x92, 0x41, 0xf7, 0x01, 0x0a, 0x04, 0x4f, 0xe7, 0xab, 0xaf, 0x12,
+	0x24, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0x85, 0xa8, 0xe5, 0xb9, 0xb3, 0xe5, 0x8f, 0xb0,
+	0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0xe6,
+	0x8a, 0xa5, 0xe8, 0xa1, 0xa8, 0x4a, 0xc8, 0x01, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0xc0, 0x01,
+	0x0a, 0x02, 0x4f, 0x4b, 0x22, 0xb9, 0x01, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0xa4, 0x01, 0x7b, 0x22, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x3a, 0x20, 0x22, 0x35, 0x32,
+	0x33, 0x31, 0x30, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x76, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22,
+	0x3a, 0x20, 0x34, 0x2e, 0x33, 0x37, 0x2c, 0x20, 0x22, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x52, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x20, 0x30, 0x2e, 0x39, 0x33, 0x2c, 0x20, 0x22, 0x6d,
+	0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x31, 0x30, 0x30, 0x38, 0x36, 0x22, 0x2c, 0x20, 0x22, 0x64, 0x61, 0x75, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x37, 0x34, 0x32, 0x22,
+	0x2c, 0x20, 0x22, 0x74, 0x6f, 0x70, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x3a,
+	0x20, 0x5b, 0x22, 0xe8, 0xb4, 0xa8, 0xe9, 0x87, 0x8f, 0x22, 0x2c, 0x20, 0x22, 0xe7, 0x89, 0xa9,
+	0xe6, 0xb5, 0x81, 0x22, 0x2c, 0x20, 0x22, 0xe5, 0x8c, 0x85, 0xe8, 0xa3, 0x85, 0x22, 0x5d, 0x7d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x2f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x9c, 0x04, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x6f,
+	0x75, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x6f, 0x75, 0x73, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xad, 0x03, 0x92, 0x41, 0x8c,
+	0x03, 0x0a, 0x04, 0x4f, 0xe7, 0xab, 0xaf, 0x12, 0x27, 0xe6, 0x9f, 0xa5, 0xe7, 0x9c, 0x8b, 0xe5,
+	0xba, 0x97, 0xe9, 0x93, 0xba, 0xe4, 0xb8, 0x8b, 0xe5, 0xbc, 0x82, 0xe5, 0xb8, 0xb8, 0xe5, 0x88,
+	0x86, 0xe6, 0x95, 0xb0, 0xe9, 0xab, 0x98, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7,
+	0x4a, 0xda, 0x02, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0xd2, 0x02, 0x0a, 0x02, 0x4f, 0x4b, 0x22,
+	0xcb, 0x02, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0xb6, 0x02, 0x7b, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x3a, 0x20,
+	0x5b, 0x7b, 0x22, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x31,
+	0x36, 0x32, 0x30, 0x30, 0x36, 0x33, 0x39, 0x37, 0x36, 0x35, 0x38, 0x33, 0x35, 0x34, 0x38, 0x39,
+	0x32, 0x38, 0x22, 0x2c, 0x20, 0x22, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22,
+	0x31, 0x30, 0x30, 0x30, 0x31, 0x22, 0x2c, 0x20, 0x22, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44,
+	0x22, 0x3a, 0x20, 0x22, 0x32, 0x30, 0x30, 0x30, 0x31, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x22, 0x3a, 0x20, 0x35, 0x2c, 0x20, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x35, 0x2c, 0x20, 0x22, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x2c, 0x20, 0x22, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x3a, 0x20, 0x22, 0xe7, 0x89, 0xa9, 0xe6, 0xb5, 0x81,
+	0xe5, 0xbe, 0x88, 0xe5, 0xbf, 0xab, 0xef, 0xbc, 0x8c, 0xe5, 0x8c, 0x85, 0xe8, 0xa3, 0x85, 0xe5,
+	0xae, 0x8c, 0xe5, 0xa5, 0xbd, 0x22, 0x2c, 0x20, 0x22, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x3a, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x3a, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x3a, 0x20, 0x32, 0x30, 0x2c, 0x20, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x34, 0x38, 0x30, 0x2c, 0x20, 0x22, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x32, 0x30, 0x32, 0x33, 0x2d, 0x30,
+	0x36, 0x2d, 0x30, 0x31, 0x54, 0x32, 0x30, 0x3a, 0x33, 0x30, 0x3a, 0x30, 0x30, 0x2b, 0x30, 0x38,
+	0x3a, 0x30, 0x30, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x30, 0x2e, 0x38, 0x31, 0x7d, 0x5d, 0x7d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
+	0x2f, 0x7b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x7d, 0x12, 0xcf, 0x02, 0x0a, 0x14, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xe0, 0x01, 0x92, 0x41, 0xbf, 0x01,
+	0x0a, 0x04, 0x42, 0xe7, 0xab, 0xaf, 0x12, 0x2a, 0xe5, 0xaf, 0xb9, 0xe6, 0xaf, 0x94, 0xe5, 0xba,
+	0x97, 0xe9, 0x93, 0xba, 0xe4, 0xb8, 0xa4, 0xe4, 0xb8, 0xaa, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0xe6, 0xae, 0xb5, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0xe6, 0x95, 0xb0, 0xe6,
+	0x8d, 0xae, 0x4a, 0x8a, 0x01, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x82, 0x01, 0x0a, 0x02, 0x4f,
+	0x4b, 0x22, 0x7c, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x68, 0x7b, 0x22, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41,
+	0x22, 0x3a, 0x20, 0x7b, 0x22, 0x61, 0x76, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a, 0x20,
+	0x34, 0x2e, 0x32, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x3a, 0x20, 0x22, 0x33, 0x32, 0x30, 0x22, 0x7d, 0x2c, 0x20, 0x22, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x42, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x61, 0x76, 0x67, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x22, 0x3a, 0x20, 0x34, 0x2e, 0x35, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x34, 0x31, 0x30, 0x22, 0x7d, 0x7d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x12, 0x5a, 0x0a, 0x0e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0xd6, 0x02, 0x0a, 0x12, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x12,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0xed, 0x01, 0x92, 0x41, 0xc0, 0x01, 0x0a, 0x04, 0x42, 0xe7, 0xab, 0xaf, 0x12, 0x29,
+	0xe6, 0x9f, 0xa5, 0xe7, 0x9c, 0x8b, 0xe5, 0xba, 0x97, 0xe9, 0x93, 0xba, 0xe6, 0x9c, 0x80, 0xe8,
+	0xbf, 0x91, 0x32, 0x34, 0xe5, 0xb0, 0x8f, 0xe6, 0x97, 0xb6, 0xe7, 0x9a, 0x84, 0xe7, 0x83, 0xad,
+	0xe9, 0x97, 0xa8, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x4a, 0x8c, 0x01, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x84, 0x01, 0x0a, 0x02, 0x4f, 0x4b, 0x22, 0x7e, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x6a, 0x7b, 0x22,
+	0x6c, 0x69, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x5b, 0x7b, 0x22, 0x74, 0x61, 0x67, 0x49, 0x44, 0x22,
+	0x3a, 0x20, 0x22, 0x37, 0x22, 0x2c, 0x20, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0xe7, 0x89, 0xa9, 0xe6, 0xb5, 0x81, 0xe5, 0xbf, 0xab, 0x22, 0x2c, 0x20, 0x22, 0x74, 0x6f, 0x64,
+	0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x34, 0x32, 0x22, 0x2c, 0x20,
+	0x22, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x41, 0x76, 0x67, 0x22, 0x3a, 0x20, 0x31, 0x32, 0x2e,
+	0x35, 0x2c, 0x20, 0x22, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a,
+	0x20, 0x32, 0x2e, 0x33, 0x36, 0x7d, 0x5d, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x7b, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x49, 0x44, 0x7d, 0x2f, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x61, 0x67,
+	0x73, 0x12, 0x83, 0x02, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9a, 0x01, 0x92, 0x41, 0x7a,
+	0x0a, 0x04, 0x43, 0xe7, 0xab, 0xaf, 0x12, 0x1e, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe5, 0x88,
+	0x86, 0xe6, 0xad, 0xa5, 0xe9, 0xaa, 0xa4, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0xe7, 0x9a, 0x84,
+	0xe4, 0xbc, 0x9a, 0xe8, 0xaf, 0x9d, 0x4a, 0x52, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x4b, 0x0a,
+	0x02, 0x4f, 0x4b, 0x22, 0x45, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x31, 0x7b, 0x22, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x39, 0x66, 0x38, 0x36, 0x64, 0x30, 0x38, 0x31,
+	0x38, 0x38, 0x34, 0x63, 0x37, 0x64, 0x36, 0x35, 0x39, 0x61, 0x32, 0x66, 0x65, 0x61, 0x61, 0x30,
+	0x63, 0x35, 0x35, 0x61, 0x64, 0x30, 0x31, 0x35, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0xf4, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x88, 0x01, 0x92, 0x41, 0x57, 0x0a, 0x04, 0x43, 0xe7, 0xab, 0xaf, 0x12,
+	0x2a, 0xe4, 0xbf, 0x9d, 0xe5, 0xad, 0x98, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0xe4, 0xbc, 0x9a,
+	0xe8, 0xaf, 0x9d, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0x80, 0xe6, 0xad, 0xa5, 0xe5, 0xa1, 0xab, 0xe5,
+	0x86, 0x99, 0xe7, 0x9a, 0x84, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x4a, 0x23, 0x0a, 0x03, 0x32,
+	0x30, 0x30, 0x12, 0x1c, 0x0a, 0x02, 0x4f, 0x4b, 0x22, 0x16, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x02, 0x7b, 0x7d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x7d, 0x2f, 0x73, 0x74, 0x65, 0x70, 0x12, 0xa1,
+	0x02, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xb5, 0x01, 0x92, 0x41, 0x81,
+	0x01, 0x0a, 0x04, 0x43, 0xe7, 0xab, 0xaf, 0x12, 0x33, 0xe6, 0x8f, 0x90, 0xe4, 0xba, 0xa4, 0xe8,
+	0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0xe4, 0xbc, 0x9a, 0xe8, 0xaf, 0x9d, 0xef, 0xbc, 0x8c, 0xe4, 0xb8,
+	0x89, 0xe6, 0xad, 0xa5, 0xe9, 0x83, 0xbd, 0xe5, 0xae, 0x8c, 0xe6, 0x88, 0x90, 0xe5, 0x90, 0x8e,
+	0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0x4a, 0x44, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x3d, 0x0a, 0x02, 0x4f, 0x4b, 0x22, 0x37, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x7b,
+	0x22, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x36, 0x32,
+	0x30, 0x30, 0x36, 0x33, 0x39, 0x37, 0x36, 0x35, 0x38, 0x33, 0x35, 0x34, 0x38, 0x39, 0x32, 0x38,
+	0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f,
+	0x7b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x12, 0x93, 0x04, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x42, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x42, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa7,
+	0x03, 0x92, 0x41, 0x80, 0x03, 0x0a, 0x04, 0x4f, 0xe7, 0xab, 0xaf, 0x12, 0x1e, 0xe6, 0x8c, 0x89,
+	0xe5, 0xba, 0x97, 0xe9, 0x93, 0xba, 0xe5, 0x92, 0x8c, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe6,
+	0x9f, 0xa5, 0xe7, 0x9c, 0x8b, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0x4a, 0xd7, 0x02, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0xcf, 0x02, 0x0a, 0x02, 0x4f, 0x4b, 0x22, 0xc8, 0x02, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0xb3, 0x02, 0x7b, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x5b, 0x7b, 0x22, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x36, 0x32, 0x30, 0x30, 0x36,
+	0x33, 0x39, 0x37, 0x36, 0x35, 0x38, 0x33, 0x35, 0x34, 0x38, 0x39, 0x32, 0x38, 0x22, 0x2c, 0x20,
+	0x22, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x30, 0x30, 0x30, 0x31,
+	0x22, 0x2c, 0x20, 0x22, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x3a, 0x20, 0x22, 0x32,
+	0x30, 0x30, 0x30, 0x31, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a, 0x20,
+	0x35, 0x2c, 0x20, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0x3a, 0x20, 0x35, 0x2c, 0x20, 0x22, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x2c, 0x20, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x22, 0x3a, 0x20, 0x22, 0xe7, 0x89, 0xa9, 0xe6, 0xb5, 0x81, 0xe5, 0xbe, 0x88, 0xe5, 0xbf,
+	0xab, 0xef, 0xbc, 0x8c, 0xe5, 0x8c, 0x85, 0xe8, 0xa3, 0x85, 0xe5, 0xae, 0x8c, 0xe5, 0xa5, 0xbd,
+	0x22, 0x2c, 0x20, 0x22, 0x70, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3a, 0x20, 0x22, 0x22,
+	0x2c, 0x20, 0x22, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3a, 0x20, 0x22,
+	0x22, 0x2c, 0x20, 0x22, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x2c,
+	0x20, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x34, 0x38, 0x30, 0x2c, 0x20, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x74, 0x22, 0x3a, 0x20, 0x22, 0x32, 0x30, 0x32, 0x33, 0x2d, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x54,
+	0x32, 0x30, 0x3a, 0x33, 0x30, 0x3a, 0x30, 0x30, 0x2b, 0x30, 0x38, 0x3a, 0x30, 0x30, 0x22, 0x2c,
+	0x20, 0x22, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a,
+	0x20, 0x30, 0x7d, 0x5d, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x7b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x7d,
+	0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x42, 0x6d, 0x92, 0x41, 0x38, 0x12, 0x12, 0x0a,
+	0x0c, 0xe8, 0xaf, 0x84, 0xe4, 0xbb, 0xb7, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x32, 0x02, 0x76,
+	0x31, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x1f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_review_v1_review_proto_rawDescOnce sync.Once
+	file_api_review_v1_review_proto_rawDescData = file_api_review_v1_review_proto_rawDesc
+)
+
+func file_api_review_v1_review_proto_rawDescGZIP() []byte {
+	file_api_review_v1_review_proto_rawDescOnce.Do(func() {
+		file_api_review_v1_review_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_review_v1_review_proto_rawDescData)
+	})
+	return file_api_review_v1_review_proto_rawDescData
+}
+
+var file_api_review_v1_review_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_api_review_v1_review_proto_goTypes = []interface{}{
+	(*CreateReviewRequest)(nil),            // 0: api.review.v1.CreateReviewRequest
+	(*CreateReviewReply)(nil),              // 1: api.review.v1.CreateReviewReply
+	(*GetReviewRequest)(nil),               // 2: api.review.v1.GetReviewRequest
+	(*GetReviewReply)(nil),                 // 3: api.review.v1.GetReviewReply
+	(*ReviewInfo)(nil),                     // 4: api.review.v1.ReviewInfo
+	(*AuditReviewRequest)(nil),             // 5: api.review.v1.AuditReviewRequest
+	(*AuditReviewReply)(nil),               // 6: api.review.v1.AuditReviewReply
+	(*ReplyReviewRequest)(nil),             // 7: api.review.v1.ReplyReviewRequest
+	(*ReplyReviewReply)(nil),               // 8: api.review.v1.ReplyReviewReply
+	(*AppealReviewRequest)(nil),            // 9: api.review.v1.AppealReviewRequest
+	(*AppealReviewReply)(nil),              // 10: api.review.v1.AppealReviewReply
+	(*AuditAppealRequest)(nil),             // 11: api.review.v1.AuditAppealRequest
+	(*AuditAppealReply)(nil),               // 12: api.review.v1.AuditAppealReply
+	(*ListReviewByUserIDRequest)(nil),      // 13: api.review.v1.ListReviewByUserIDRequest
+	(*ListReviewByUserIDReply)(nil),        // 14: api.review.v1.ListReviewByUserIDReply
+	(*BulkTagReviewsRequest)(nil),          // 15: api.review.v1.BulkTagReviewsRequest
+	(*BulkTagReviewsReply)(nil),            // 16: api.review.v1.BulkTagReviewsReply
+	(*RequestWithdrawalRequest)(nil),       // 17: api.review.v1.RequestWithdrawalRequest
+	(*RequestWithdrawalReply)(nil),         // 18: api.review.v1.RequestWithdrawalReply
+	(*AuditWithdrawalRequest)(nil),         // 19: api.review.v1.AuditWithdrawalRequest
+	(*AuditWithdrawalReply)(nil),           // 20: api.review.v1.AuditWithdrawalReply
+	(*DequeueModerationRequest)(nil),       // 21: api.review.v1.DequeueModerationRequest
+	(*DequeueModerationReply)(nil),         // 22: api.review.v1.DequeueModerationReply
+	(*GetModerationQueueDepthRequest)(nil), // 23: api.review.v1.GetModerationQueueDepthRequest
+	(*GetModerationQueueDepthReply)(nil),   // 24: api.review.v1.GetModerationQueueDepthReply
+	(*BulkGetReviewStatsRequest)(nil),      // 25: api.review.v1.BulkGetReviewStatsRequest
+	(*ProductStat)(nil),                    // 26: api.review.v1.ProductStat
+	(*BulkGetReviewStatsReply)(nil),        // 27: api.review.v1.BulkGetReviewStatsReply
+	(*GetPlatformReportRequest)(nil),       // 28: api.review.v1.GetPlatformReportRequest
+	(*GetPlatformReportReply)(nil),         // 29: api.review.v1.GetPlatformReportReply
+	(*ListAnomalousReviewsRequest)(nil),    // 30: api.review.v1.ListAnomalousReviewsRequest
+	(*ListAnomalousReviewsReply)(nil),      // 31: api.review.v1.ListAnomalousReviewsReply
+	(*DateRange)(nil),                      // 32: api.review.v1.DateRange
+	(*CompareReviewPeriodsRequest)(nil),    // 33: api.review.v1.CompareReviewPeriodsRequest
+	(*PeriodStats)(nil),                    // 34: api.review.v1.PeriodStats
+	(*CompareReviewPeriodsReply)(nil),      // 35: api.review.v1.CompareReviewPeriodsReply
+	(*ExportUserDataRequest)(nil),          // 36: api.review.v1.ExportUserDataRequest
+	(*ExportUserDataReply)(nil),            // 37: api.review.v1.ExportUserDataReply
+	(*DetectTrendingTagsRequest)(nil),      // 38: api.review.v1.DetectTrendingTagsRequest
+	(*TrendingTag)(nil),                    // 39: api.review.v1.TrendingTag
+	(*DetectTrendingTagsReply)(nil),        // 40: api.review.v1.DetectTrendingTagsReply
+	(*StartReviewSessionRequest)(nil),      // 41: api.review.v1.StartReviewSessionRequest
+	(*StartReviewSessionReply)(nil),        // 42: api.review.v1.StartReviewSessionReply
+	(*UpdateReviewSessionRequest)(nil),     // 43: api.review.v1.UpdateReviewSessionRequest
+	(*UpdateReviewSessionReply)(nil),       // 44: api.review.v1.UpdateReviewSessionReply
+	(*CommitReviewSessionRequest)(nil),     // 45: api.review.v1.CommitReviewSessionRequest
+	(*CommitReviewSessionReply)(nil),       // 46: api.review.v1.CommitReviewSessionReply
+	(*ListReviewByStoreIDRequest)(nil),     // 47: api.review.v1.ListReviewByStoreIDRequest
+	(*ListReviewByStoreIDReply)(nil),       // 48: api.review.v1.ListReviewByStoreIDReply
+	nil,                                    // 49: api.review.v1.BulkGetReviewStatsReply.StatsEntry
+	(*structpb.Struct)(nil),                // 50: google.protobuf.Struct
+}
+var file_api_review_v1_review_proto_depIdxs = []int32{
+	4,  // 0: api.review.v1.GetReviewReply.data:type_name -> api.review.v1.ReviewInfo
+	4,  // 1: api.review.v1.ListReviewByUserIDReply.list:type_name -> api.review.v1.ReviewInfo
+	49, // 2: api.review.v1.BulkGetReviewStatsReply.stats:type_name -> api.review.v1.BulkGetReviewStatsReply.StatsEntry
+	4,  // 3: api.review.v1.ListAnomalousReviewsReply.list:type_name -> api.review.v1.ReviewInfo
+	32, // 4: api.review.v1.CompareReviewPeriodsRequest.periodA:type_name -> api.review.v1.DateRange
+	32, // 5: api.review.v1.CompareReviewPeriodsRequest.periodB:type_name -> api.review.v1.DateRange
+	34, // 6: api.review.v1.CompareReviewPeriodsReply.periodA:type_name -> api.review.v1.PeriodStats
+	34, // 7: api.review.v1.CompareReviewPeriodsReply.periodB:type_name -> api.review.v1.PeriodStats
+	39, // 8: api.review.v1.DetectTrendingTagsReply.list:type_name -> api.review.v1.TrendingTag
+	50, // 9: api.review.v1.UpdateReviewSessionRequest.data:type_name -> google.protobuf.Struct
+	4,  // 10: api.review.v1.ListReviewByStoreIDReply.list:type_name -> api.review.v1.ReviewInfo
+	26, // 11: api.review.v1.BulkGetReviewStatsReply.StatsEntry.value:type_name -> api.review.v1.ProductStat
+	0,  // 12: api.review.v1.Review.CreateReview:input_type -> api.review.v1.CreateReviewRequest
+	2,  // 13: api.review.v1.Review.GetReview:input_type -> api.review.v1.GetReviewRequest
+	5,  // 14: api.review.v1.Review.AuditReview:input_type -> api.review.v1.AuditReviewRequest
+	7,  // 15: api.review.v1.Review.ReplyReview:input_type -> api.review.v1.ReplyReviewRequest
+	9,  // 16: api.review.v1.Review.AppealReview:input_type -> api.review.v1.AppealReviewRequest
+	11, // 17: api.review.v1.Review.AuditAppeal:input_type -> api.review.v1.AuditAppealRequest
+	13, // 18: api.review.v1.Review.ListReviewByUserID:input_type -> api.review.v1.ListReviewByUserIDRequest
+	15, // 19: api.review.v1.Review.BulkTagReviews:input_type -> api.review.v1.BulkTagReviewsRequest
+	17, // 20: api.review.v1.Review.RequestWithdrawal:input_type -> api.review.v1.RequestWithdrawalRequest
+	19, // 21: api.review.v1.Review.ApproveWithdrawal:input_type -> api.review.v1.AuditWithdrawalRequest
+	19, // 22: api.review.v1.Review.DenyWithdrawal:input_type -> api.review.v1.AuditWithdrawalRequest
+	21, // 23: api.review.v1.Review.DequeueModeration:input_type -> api.review.v1.DequeueModerationRequest
+	23, // 24: api.review.v1.Review.GetModerationQueueDepth:input_type -> api.review.v1.GetModerationQueueDepthRequest
+	25, // 25: api.review.v1.Review.BulkGetReviewStats:input_type -> api.review.v1.BulkGetReviewStatsRequest
+	28, // 26: api.review.v1.Review.GetPlatformReport:input_type -> api.review.v1.GetPlatformReportRequest
+	30, // 27: api.review.v1.Review.ListAnomalousReviews:input_type -> api.review.v1.ListAnomalousReviewsRequest
+	33, // 28: api.review.v1.Review.CompareReviewPeriods:input_type -> api.review.v1.CompareReviewPeriodsRequest
+	36, // 29: api.review.v1.Review.ExportUserData:input_type -> api.review.v1.ExportUserDataRequest
+	38, // 30: api.review.v1.Review.DetectTrendingTags:input_type -> api.review.v1.DetectTrendingTagsRequest
+	41, // 31: api.review.v1.Review.StartReviewSession:input_type -> api.review.v1.StartReviewSessionRequest
+	43, // 32: api.review.v1.Review.UpdateReviewSession:input_type -> api.review.v1.UpdateReviewSessionRequest
+	45, // 33: api.review.v1.Review.CommitReviewSession:input_type -> api.review.v1.CommitReviewSessionRequest
+	47, // 34: api.review.v1.Review.ListReviewByStoreID:input_type -> api.review.v1.ListReviewByStoreIDRequest
+	1,  // 35: api.review.v1.Review.CreateReview:output_type -> api.review.v1.CreateReviewReply
+	3,  // 36: api.review.v1.Review.GetReview:output_type -> api.review.v1.GetReviewReply
+	6,  // 37: api.review.v1.Review.AuditReview:output_type -> api.review.v1.AuditReviewReply
+	8,  // 38: api.review.v1.Review.ReplyReview:output_type -> api.review.v1.ReplyReviewReply
+	10, // 39: api.review.v1.Review.AppealReview:output_type -> api.review.v1.AppealReviewReply
+	12, // 40: api.review.v1.Review.AuditAppeal:output_type -> api.review.v1.AuditAppealReply
+	14, // 41: api.review.v1.Review.ListReviewByUserID:output_type -> api.review.v1.ListReviewByUserIDReply
+	16, // 42: api.review.v1.Review.BulkTagReviews:output_type -> api.review.v1.BulkTagReviewsReply
+	18, // 43: api.review.v1.Review.RequestWithdrawal:output_type -> api.review.v1.RequestWithdrawalReply
+	20, // 44: api.review.v1.Review.ApproveWithdrawal:output_type -> api.review.v1.AuditWithdrawalReply
+	20, // 45: api.review.v1.Review.DenyWithdrawal:output_type -> api.review.v1.AuditWithdrawalReply
+	22, // 46: api.review.v1.Review.DequeueModeration:output_type -> api.review.v1.DequeueModerationReply
+	24, // 47: api.review.v1.Review.GetModerationQueueDepth:output_type -> api.review.v1.GetModerationQueueDepthReply
+	27, // 48: api.review.v1.Review.BulkGetReviewStats:output_type -> api.review.v1.BulkGetReviewStatsReply
+	29, // 49: api.review.v1.Review.GetPlatformReport:output_type -> api.review.v1.GetPlatformReportReply
+	31, // 50: api.review.v1.Review.ListAnomalousReviews:output_type -> api.review.v1.ListAnomalousReviewsReply
+	35, // 51: api.review.v1.Review.CompareReviewPeriods:output_type -> api.review.v1.CompareReviewPeriodsReply
+	37, // 52: api.review.v1.Review.ExportUserData:output_type -> api.review.v1.ExportUserDataReply
+	40, // 53: api.review.v1.Review.DetectTrendingTags:output_type -> api.review.v1.DetectTrendingTagsReply
+	42, // 54: api.review.v1.Review.StartReviewSession:output_type -> api.review.v1.StartReviewSessionReply
+	44, // 55: api.review.v1.Review.UpdateReviewSession:output_type -> api.review.v1.UpdateReviewSessionReply
+	46, // 56: api.review.v1.Review.CommitReviewSession:output_type -> api.review.v1.CommitReviewSessionReply
+	48, // 57: api.review.v1.Review.ListReviewByStoreID:output_type -> api.review.v1.ListReviewByStoreIDReply
+	35, // [35:58] is the sub-list for method output_type
+	12, // [12:35] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_api_review_v1_review_proto_init() }
+func file_api_review_v1_review_proto_init() {
+	if File_api_review_v1_review_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_review_v1_review_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReviewReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReviewReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditReviewReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyReviewReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppealReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppealReviewReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditAppealRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditAppealReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReviewByUserIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReviewByUserIDReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkTagReviewsRequest); i {
+			case 0:
 				return &v.state
 			case 1:
 				return &v.sizeCache
@@ -1353,8 +4207,8 @@ func file_api_review_v1_review_proto_init() {
 				return nil
 			}
 		}
-		file_api_review_v1_review_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuditReviewRequest); i {
+		file_api_review_v1_review_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkTagReviewsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1365,8 +4219,8 @@ func file_api_review_v1_review_proto_init() {
 				return nil
 			}
 		}
-		file_api_review_v1_review_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuditReviewReply); i {
+		file_api_review_v1_review_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestWithdrawalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1377,8 +4231,8 @@ func file_api_review_v1_review_proto_init() {
 				return nil
 			}
 		}
-		file_api_review_v1_review_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyReviewRequest); i {
+		file_api_review_v1_review_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestWithdrawalReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1389,8 +4243,8 @@ func file_api_review_v1_review_proto_init() {
 				return nil
 			}
 		}
-		file_api_review_v1_review_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyReviewReply); i {
+		file_api_review_v1_review_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditWithdrawalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1401,8 +4255,8 @@ func file_api_review_v1_review_proto_init() {
 				return nil
 			}
 		}
-		file_api_review_v1_review_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppealReviewRequest); i {
+		file_api_review_v1_review_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditWithdrawalReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1413,8 +4267,8 @@ func file_api_review_v1_review_proto_init() {
 				return nil
 			}
 		}
-		file_api_review_v1_review_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppealReviewReply); i {
+		file_api_review_v1_review_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DequeueModerationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1425,8 +4279,8 @@ func file_api_review_v1_review_proto_init() {
 				return nil
 			}
 		}
-		file_api_review_v1_review_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuditAppealRequest); i {
+		file_api_review_v1_review_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DequeueModerationReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1437,8 +4291,8 @@ func file_api_review_v1_review_proto_init() {
 				return nil
 			}
 		}
-		file_api_review_v1_review_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuditAppealReply); i {
+		file_api_review_v1_review_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModerationQueueDepthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1449,8 +4303,8 @@ func file_api_review_v1_review_proto_init() {
 				return nil
 			}
 		}
-		file_api_review_v1_review_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListReviewByUserIDRequest); i {
+		file_api_review_v1_review_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModerationQueueDepthReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1461,8 +4315,284 @@ func file_api_review_v1_review_proto_init() {
 				return nil
 			}
 		}
-		file_api_review_v1_review_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListReviewByUserIDReply); i {
+		file_api_review_v1_review_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkGetReviewStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProductStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkGetReviewStatsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlatformReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlatformReportReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAnomalousReviewsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAnomalousReviewsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DateRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareReviewPeriodsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeriodStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareReviewPeriodsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserDataReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectTrendingTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrendingTag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectTrendingTagsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartReviewSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartReviewSessionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReviewSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReviewSessionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitReviewSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitReviewSessionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReviewByStoreIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_review_v1_review_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReviewByStoreIDReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1476,13 +4606,14 @@ func file_api_review_v1_review_proto_init() {
 	}
 	file_api_review_v1_review_proto_msgTypes[5].OneofWrappers = []interface{}{}
 	file_api_review_v1_review_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_api_review_v1_review_proto_msgTypes[19].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_review_v1_review_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,28 +20,73 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationReviewAppealReview = "/api.review.v1.Review/AppealReview"
+const OperationReviewApproveWithdrawal = "/api.review.v1.Review/ApproveWithdrawal"
 const OperationReviewAuditAppeal = "/api.review.v1.Review/AuditAppeal"
 const OperationReviewAuditReview = "/api.review.v1.Review/AuditReview"
+const OperationReviewBulkGetReviewStats = "/api.review.v1.Review/BulkGetReviewStats"
+const OperationReviewBulkTagReviews = "/api.review.v1.Review/BulkTagReviews"
+const OperationReviewCommitReviewSession = "/api.review.v1.Review/CommitReviewSession"
+const OperationReviewCompareReviewPeriods = "/api.review.v1.Review/CompareReviewPeriods"
 const OperationReviewCreateReview = "/api.review.v1.Review/CreateReview"
+const OperationReviewDenyWithdrawal = "/api.review.v1.Review/DenyWithdrawal"
+const OperationReviewDequeueModeration = "/api.review.v1.Review/DequeueModeration"
+const OperationReviewDetectTrendingTags = "/api.review.v1.Review/DetectTrendingTags"
+const OperationReviewGetModerationQueueDepth = "/api.review.v1.Review/GetModerationQueueDepth"
+const OperationReviewGetPlatformReport = "/api.review.v1.Review/GetPlatformReport"
 const OperationReviewGetReview = "/api.review.v1.Review/GetReview"
+const OperationReviewListAnomalousReviews = "/api.review.v1.Review/ListAnomalousReviews"
+const OperationReviewListReviewByStoreID = "/api.review.v1.Review/ListReviewByStoreID"
 const OperationReviewListReviewByUserID = "/api.review.v1.Review/ListReviewByUserID"
 const OperationReviewReplyReview = "/api.review.v1.Review/ReplyReview"
+const OperationReviewRequestWithdrawal = "/api.review.v1.Review/RequestWithdrawal"
+const OperationReviewStartReviewSession = "/api.review.v1.Review/StartReviewSession"
+const OperationReviewUpdateReviewSession = "/api.review.v1.Review/UpdateReviewSession"
 
 type ReviewHTTPServer interface {
 	// AppealReview B端申诉评价
 	AppealReview(context.Context, *AppealReviewRequest) (*AppealReviewReply, error)
+	// ApproveWithdrawal O端同意撤回评价
+	ApproveWithdrawal(context.Context, *AuditWithdrawalRequest) (*AuditWithdrawalReply, error)
 	// AuditAppeal O端评价申诉审核
 	AuditAppeal(context.Context, *AuditAppealRequest) (*AuditAppealReply, error)
-	// AuditReview O端审核评价
+	// AuditReview O端审核评价，需要管理密钥
 	AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error)
+	// BulkGetReviewStats 商品列表页批量获取商品的评价统计
+	BulkGetReviewStats(context.Context, *BulkGetReviewStatsRequest) (*BulkGetReviewStatsReply, error)
+	// BulkTagReviews O端按条件批量给评价打标签
+	BulkTagReviews(context.Context, *BulkTagReviewsRequest) (*BulkTagReviewsReply, error)
+	// CommitReviewSession C端提交评价会话，三步都完成后创建评价
+	CommitReviewSession(context.Context, *CommitReviewSessionRequest) (*CommitReviewSessionReply, error)
+	// CompareReviewPeriods B端对比店铺两个时间段的评价数据
+	CompareReviewPeriods(context.Context, *CompareReviewPeriodsRequest) (*CompareReviewPeriodsReply, error)
 	// CreateReview C端创建评价
 	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewReply, error)
+	// DenyWithdrawal O端拒绝撤回评价
+	DenyWithdrawal(context.Context, *AuditWithdrawalRequest) (*AuditWithdrawalReply, error)
+	// DequeueModeration O端从店铺的待审核队列中取出最紧急的一条评价
+	DequeueModeration(context.Context, *DequeueModerationRequest) (*DequeueModerationReply, error)
+	// DetectTrendingTags B端查看店铺最近24小时的热门标签
+	DetectTrendingTags(context.Context, *DetectTrendingTagsRequest) (*DetectTrendingTagsReply, error)
+	// GetModerationQueueDepth O端查看店铺待审核队列的长度
+	GetModerationQueueDepth(context.Context, *GetModerationQueueDepthRequest) (*GetModerationQueueDepthReply, error)
+	// GetPlatformReport O端获取全平台的评价统计报表
+	GetPlatformReport(context.Context, *GetPlatformReportRequest) (*GetPlatformReportReply, error)
 	// GetReview C端获取评价详情
 	GetReview(context.Context, *GetReviewRequest) (*GetReviewReply, error)
+	// ListAnomalousReviews O端查看店铺下异常分数高的评价
+	ListAnomalousReviews(context.Context, *ListAnomalousReviewsRequest) (*ListAnomalousReviewsReply, error)
+	// ListReviewByStoreID O端按店铺和状态查看评价，运维工具使用，需要管理密钥
+	ListReviewByStoreID(context.Context, *ListReviewByStoreIDRequest) (*ListReviewByStoreIDReply, error)
 	// ListReviewByUserID C端查看userID下所有评价
 	ListReviewByUserID(context.Context, *ListReviewByUserIDRequest) (*ListReviewByUserIDReply, error)
 	// ReplyReview B端回复评价
 	ReplyReview(context.Context, *ReplyReviewRequest) (*ReplyReviewReply, error)
+	// RequestWithdrawal C端申请撤回已审核通过的评价
+	RequestWithdrawal(context.Context, *RequestWithdrawalRequest) (*RequestWithdrawalReply, error)
+	// StartReviewSession C端开始分步骤评价的会话
+	StartReviewSession(context.Context, *StartReviewSessionRequest) (*StartReviewSessionReply, error)
+	// UpdateReviewSession C端保存评价会话某一步填写的数据
+	UpdateReviewSession(context.Context, *UpdateReviewSessionRequest) (*UpdateReviewSessionReply, error)
 }
 
 func RegisterReviewHTTPServer(s *http.Server, srv ReviewHTTPServer) {
@@ -53,6 +98,21 @@ func RegisterReviewHTTPServer(s *http.Server, srv ReviewHTTPServer) {
 	r.POST("/v1/review/appeal", _Review_AppealReview0_HTTP_Handler(srv))
 	r.POST("/v1/appeal/audit", _Review_AuditAppeal1_HTTP_Handler(srv))
 	r.GET("/v1/{userID}/reviews", _Review_ListReviewByUserID0_HTTP_Handler(srv))
+	r.POST("/v1/review/tag/bulk", _Review_BulkTagReviews0_HTTP_Handler(srv))
+	r.POST("/v1/review/withdrawal", _Review_RequestWithdrawal0_HTTP_Handler(srv))
+	r.POST("/v1/withdrawal/approve", _Review_ApproveWithdrawal0_HTTP_Handler(srv))
+	r.POST("/v1/withdrawal/deny", _Review_DenyWithdrawal0_HTTP_Handler(srv))
+	r.POST("/v1/moderation/dequeue", _Review_DequeueModeration0_HTTP_Handler(srv))
+	r.GET("/v1/moderation/depth/{storeID}", _Review_GetModerationQueueDepth0_HTTP_Handler(srv))
+	r.POST("/v1/review/stats/bulk", _Review_BulkGetReviewStats0_HTTP_Handler(srv))
+	r.GET("/v1/report/platform", _Review_GetPlatformReport0_HTTP_Handler(srv))
+	r.GET("/v1/anomaly/{storeID}", _Review_ListAnomalousReviews0_HTTP_Handler(srv))
+	r.POST("/v1/review/compare", _Review_CompareReviewPeriods0_HTTP_Handler(srv))
+	r.GET("/v1/store/{storeID}/trending-tags", _Review_DetectTrendingTags0_HTTP_Handler(srv))
+	r.POST("/v1/review/session", _Review_StartReviewSession0_HTTP_Handler(srv))
+	r.POST("/v1/review/session/{sessionID}/step", _Review_UpdateReviewSession0_HTTP_Handler(srv))
+	r.POST("/v1/review/session/{sessionID}/commit", _Review_CommitReviewSession0_HTTP_Handler(srv))
+	r.GET("/v1/store/{storeID}/reviews", _Review_ListReviewByStoreID0_HTTP_Handler(srv))
 }
 
 func _Review_CreateReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
@@ -209,14 +269,362 @@ func _Review_ListReviewByUserID0_HTTP_Handler(srv ReviewHTTPServer) func(ctx htt
 	}
 }
 
+func _Review_BulkTagReviews0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BulkTagReviewsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewBulkTagReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BulkTagReviews(ctx, req.(*BulkTagReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BulkTagReviewsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_RequestWithdrawal0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestWithdrawalRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewRequestWithdrawal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestWithdrawal(ctx, req.(*RequestWithdrawalRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestWithdrawalReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_ApproveWithdrawal0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AuditWithdrawalRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewApproveWithdrawal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApproveWithdrawal(ctx, req.(*AuditWithdrawalRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuditWithdrawalReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_DenyWithdrawal0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AuditWithdrawalRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewDenyWithdrawal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DenyWithdrawal(ctx, req.(*AuditWithdrawalRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuditWithdrawalReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_DequeueModeration0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DequeueModerationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewDequeueModeration)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DequeueModeration(ctx, req.(*DequeueModerationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DequeueModerationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_GetModerationQueueDepth0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetModerationQueueDepthRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewGetModerationQueueDepth)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetModerationQueueDepth(ctx, req.(*GetModerationQueueDepthRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetModerationQueueDepthReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_BulkGetReviewStats0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BulkGetReviewStatsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewBulkGetReviewStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BulkGetReviewStats(ctx, req.(*BulkGetReviewStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BulkGetReviewStatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_GetPlatformReport0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPlatformReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewGetPlatformReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPlatformReport(ctx, req.(*GetPlatformReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPlatformReportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_ListAnomalousReviews0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAnomalousReviewsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListAnomalousReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAnomalousReviews(ctx, req.(*ListAnomalousReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAnomalousReviewsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_CompareReviewPeriods0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CompareReviewPeriodsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewCompareReviewPeriods)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CompareReviewPeriods(ctx, req.(*CompareReviewPeriodsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CompareReviewPeriodsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_DetectTrendingTags0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DetectTrendingTagsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewDetectTrendingTags)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DetectTrendingTags(ctx, req.(*DetectTrendingTagsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DetectTrendingTagsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_StartReviewSession0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in StartReviewSessionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewStartReviewSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StartReviewSession(ctx, req.(*StartReviewSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*StartReviewSessionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_UpdateReviewSession0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateReviewSessionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewUpdateReviewSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateReviewSession(ctx, req.(*UpdateReviewSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateReviewSessionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_CommitReviewSession0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CommitReviewSessionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewCommitReviewSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CommitReviewSession(ctx, req.(*CommitReviewSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CommitReviewSessionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_ListReviewByStoreID0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReviewByStoreIDRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListReviewByStoreID)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReviewByStoreID(ctx, req.(*ListReviewByStoreIDRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReviewByStoreIDReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ReviewHTTPClient interface {
 	AppealReview(ctx context.Context, req *AppealReviewRequest, opts ...http.CallOption) (rsp *AppealReviewReply, err error)
+	ApproveWithdrawal(ctx context.Context, req *AuditWithdrawalRequest, opts ...http.CallOption) (rsp *AuditWithdrawalReply, err error)
 	AuditAppeal(ctx context.Context, req *AuditAppealRequest, opts ...http.CallOption) (rsp *AuditAppealReply, err error)
 	AuditReview(ctx context.Context, req *AuditReviewRequest, opts ...http.CallOption) (rsp *AuditReviewReply, err error)
+	BulkGetReviewStats(ctx context.Context, req *BulkGetReviewStatsRequest, opts ...http.CallOption) (rsp *BulkGetReviewStatsReply, err error)
+	BulkTagReviews(ctx context.Context, req *BulkTagReviewsRequest, opts ...http.CallOption) (rsp *BulkTagReviewsReply, err error)
+	CommitReviewSession(ctx context.Context, req *CommitReviewSessionRequest, opts ...http.CallOption) (rsp *CommitReviewSessionReply, err error)
+	CompareReviewPeriods(ctx context.Context, req *CompareReviewPeriodsRequest, opts ...http.CallOption) (rsp *CompareReviewPeriodsReply, err error)
 	CreateReview(ctx context.Context, req *CreateReviewRequest, opts ...http.CallOption) (rsp *CreateReviewReply, err error)
+	DenyWithdrawal(ctx context.Context, req *AuditWithdrawalRequest, opts ...http.CallOption) (rsp *AuditWithdrawalReply, err error)
+	DequeueModeration(ctx context.Context, req *DequeueModerationRequest, opts ...http.CallOption) (rsp *DequeueModerationReply, err error)
+	DetectTrendingTags(ctx context.Context, req *DetectTrendingTagsRequest, opts ...http.CallOption) (rsp *DetectTrendingTagsReply, err error)
+	GetModerationQueueDepth(ctx context.Context, req *GetModerationQueueDepthRequest, opts ...http.CallOption) (rsp *GetModerationQueueDepthReply, err error)
+	GetPlatformReport(ctx context.Context, req *GetPlatformReportRequest, opts ...http.CallOption) (rsp *GetPlatformReportReply, err error)
 	GetReview(ctx context.Context, req *GetReviewRequest, opts ...http.CallOption) (rsp *GetReviewReply, err error)
+	ListAnomalousReviews(ctx context.Context, req *ListAnomalousReviewsRequest, opts ...http.CallOption) (rsp *ListAnomalousReviewsReply, err error)
+	ListReviewByStoreID(ctx context.Context, req *ListReviewByStoreIDRequest, opts ...http.CallOption) (rsp *ListReviewByStoreIDReply, err error)
 	ListReviewByUserID(ctx context.Context, req *ListReviewByUserIDRequest, opts ...http.CallOption) (rsp *ListReviewByUserIDReply, err error)
 	ReplyReview(ctx context.Context, req *ReplyReviewRequest, opts ...http.CallOption) (rsp *ReplyReviewReply, err error)
+	RequestWithdrawal(ctx context.Context, req *RequestWithdrawalRequest, opts ...http.CallOption) (rsp *RequestWithdrawalReply, err error)
+	StartReviewSession(ctx context.Context, req *StartReviewSessionRequest, opts ...http.CallOption) (rsp *StartReviewSessionReply, err error)
+	UpdateReviewSession(ctx context.Context, req *UpdateReviewSessionRequest, opts ...http.CallOption) (rsp *UpdateReviewSessionReply, err error)
 }
 
 type ReviewHTTPClientImpl struct {
@@ -240,6 +648,19 @@ func (c *ReviewHTTPClientImpl) AppealReview(ctx context.Context, in *AppealRevie
 	return &out, err
 }
 
+func (c *ReviewHTTPClientImpl) ApproveWithdrawal(ctx context.Context, in *AuditWithdrawalRequest, opts ...http.CallOption) (*AuditWithdrawalReply, error) {
+	var out AuditWithdrawalReply
+	pattern := "/v1/withdrawal/approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewApproveWithdrawal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ReviewHTTPClientImpl) AuditAppeal(ctx context.Context, in *AuditAppealRequest, opts ...http.CallOption) (*AuditAppealReply, error) {
 	var out AuditAppealReply
 	pattern := "/v1/appeal/audit"
@@ -266,6 +687,58 @@ func (c *ReviewHTTPClientImpl) AuditReview(ctx context.Context, in *AuditReviewR
 	return &out, err
 }
 
+func (c *ReviewHTTPClientImpl) BulkGetReviewStats(ctx context.Context, in *BulkGetReviewStatsRequest, opts ...http.CallOption) (*BulkGetReviewStatsReply, error) {
+	var out BulkGetReviewStatsReply
+	pattern := "/v1/review/stats/bulk"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewBulkGetReviewStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ReviewHTTPClientImpl) BulkTagReviews(ctx context.Context, in *BulkTagReviewsRequest, opts ...http.CallOption) (*BulkTagReviewsReply, error) {
+	var out BulkTagReviewsReply
+	pattern := "/v1/review/tag/bulk"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewBulkTagReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ReviewHTTPClientImpl) CommitReviewSession(ctx context.Context, in *CommitReviewSessionRequest, opts ...http.CallOption) (*CommitReviewSessionReply, error) {
+	var out CommitReviewSessionReply
+	pattern := "/v1/review/session/{sessionID}/commit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewCommitReviewSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ReviewHTTPClientImpl) CompareReviewPeriods(ctx context.Context, in *CompareReviewPeriodsRequest, opts ...http.CallOption) (*CompareReviewPeriodsReply, error) {
+	var out CompareReviewPeriodsReply
+	pattern := "/v1/review/compare"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewCompareReviewPeriods))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ReviewHTTPClientImpl) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...http.CallOption) (*CreateReviewReply, error) {
 	var out CreateReviewReply
 	pattern := "/v1/review"
@@ -279,6 +752,71 @@ func (c *ReviewHTTPClientImpl) CreateReview(ctx context.Context, in *CreateRevie
 	return &out, err
 }
 
+func (c *ReviewHTTPClientImpl) DenyWithdrawal(ctx context.Context, in *AuditWithdrawalRequest, opts ...http.CallOption) (*AuditWithdrawalReply, error) {
+	var out AuditWithdrawalReply
+	pattern := "/v1/withdrawal/deny"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewDenyWithdrawal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ReviewHTTPClientImpl) DequeueModeration(ctx context.Context, in *DequeueModerationRequest, opts ...http.CallOption) (*DequeueModerationReply, error) {
+	var out DequeueModerationReply
+	pattern := "/v1/moderation/dequeue"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewDequeueModeration))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ReviewHTTPClientImpl) DetectTrendingTags(ctx context.Context, in *DetectTrendingTagsRequest, opts ...http.CallOption) (*DetectTrendingTagsReply, error) {
+	var out DetectTrendingTagsReply
+	pattern := "/v1/store/{storeID}/trending-tags"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewDetectTrendingTags))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ReviewHTTPClientImpl) GetModerationQueueDepth(ctx context.Context, in *GetModerationQueueDepthRequest, opts ...http.CallOption) (*GetModerationQueueDepthReply, error) {
+	var out GetModerationQueueDepthReply
+	pattern := "/v1/moderation/depth/{storeID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewGetModerationQueueDepth))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ReviewHTTPClientImpl) GetPlatformReport(ctx context.Context, in *GetPlatformReportRequest, opts ...http.CallOption) (*GetPlatformReportReply, error) {
+	var out GetPlatformReportReply
+	pattern := "/v1/report/platform"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewGetPlatformReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ReviewHTTPClientImpl) GetReview(ctx context.Context, in *GetReviewRequest, opts ...http.CallOption) (*GetReviewReply, error) {
 	var out GetReviewReply
 	pattern := "/v1/review/{reviewID}"
@@ -292,6 +830,32 @@ func (c *ReviewHTTPClientImpl) GetReview(ctx context.Context, in *GetReviewReque
 	return &out, err
 }
 
+func (c *ReviewHTTPClientImpl) ListAnomalousReviews(ctx context.Context, in *ListAnomalousReviewsRequest, opts ...http.CallOption) (*ListAnomalousReviewsReply, error) {
+	var out ListAnomalousReviewsReply
+	pattern := "/v1/anomaly/{storeID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListAnomalousReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ReviewHTTPClientImpl) ListReviewByStoreID(ctx context.Context, in *ListReviewByStoreIDRequest, opts ...http.CallOption) (*ListReviewByStoreIDReply, error) {
+	var out ListReviewByStoreIDReply
+	pattern := "/v1/store/{storeID}/reviews"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListReviewByStoreID))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ReviewHTTPClientImpl) ListReviewByUserID(ctx context.Context, in *ListReviewByUserIDRequest, opts ...http.CallOption) (*ListReviewByUserIDReply, error) {
 	var out ListReviewByUserIDReply
 	pattern := "/v1/{userID}/reviews"
@@ -317,3 +881,42 @@ func (c *ReviewHTTPClientImpl) ReplyReview(ctx context.Context, in *ReplyReviewR
 	}
 	return &out, err
 }
+
+func (c *ReviewHTTPClientImpl) RequestWithdrawal(ctx context.Context, in *RequestWithdrawalRequest, opts ...http.CallOption) (*RequestWithdrawalReply, error) {
+	var out RequestWithdrawalReply
+	pattern := "/v1/review/withdrawal"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewRequestWithdrawal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ReviewHTTPClientImpl) StartReviewSession(ctx context.Context, in *StartReviewSessionRequest, opts ...http.CallOption) (*StartReviewSessionReply, error) {
+	var out StartReviewSessionReply
+	pattern := "/v1/review/session"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewStartReviewSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ReviewHTTPClientImpl) UpdateReviewSession(ctx context.Context, in *UpdateReviewSessionRequest, opts ...http.CallOption) (*UpdateReviewSessionReply, error) {
+	var out UpdateReviewSessionReply
+	pattern := "/v1/review/session/{sessionID}/step"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewUpdateReviewSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
@@ -7,6 +7,7 @@ require (
 	github.com/go-kratos/kratos/contrib/registry/consul/v2 v2.0.0-20231109033548-702f96c13e7f
 	github.com/go-kratos/kratos/v2 v2.7.1
 	github.com/google/wire v0.5.0
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.16.0
 	github.com/hashicorp/consul/api v1.26.1
 	go.uber.org/automaxprocs v1.5.1
 	google.golang.org/genproto/googleapis/api v0.0.0-20230629202037-9506855d4529
@@ -140,6 +140,28 @@ func (m *CreateReviewRequest) validate(all bool) error {
 
 	// no validation rules for Anonymous
 
+	if m.GetSpuID() <= 0 {
+		err := CreateReviewRequestValidationError{
+			field:  "SpuID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSkuID() <= 0 {
+		err := CreateReviewRequestValidationError{
+			field:  "SkuID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateReviewRequestMultiError(errors)
 	}
@@ -630,6 +652,12 @@ func (m *ReviewInfo) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for TimezoneOffset
+
+	// no validation rules for CreateAt
+
+	// no validation rules for AnomalyScore
+
 	if len(errors) > 0 {
 		return ReviewInfoMultiError(errors)
 	}
@@ -740,10 +768,10 @@ func (m *AuditReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetStatus() <= 0 {
+	if _, ok := _AuditReviewRequest_Status_InLookup[m.GetStatus()]; !ok {
 		err := AuditReviewRequestValidationError{
 			field:  "Status",
-			reason: "value must be greater than 0",
+			reason: "value must be in list [20 30]",
 		}
 		if !all {
 			return err
@@ -857,6 +885,11 @@ var _ interface {
 	ErrorName() string
 } = AuditReviewRequestValidationError{}
 
+var _AuditReviewRequest_Status_InLookup = map[int32]struct{}{
+	20: {},
+	30: {},
+}
+
 // Validate checks the field values on AuditReviewReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1976,3 +2009,4274 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListReviewByUserIDReplyValidationError{}
+
+// Validate checks the field values on BulkTagReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkTagReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkTagReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkTagReviewsRequestMultiError, or nil if none found.
+func (m *BulkTagReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkTagReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTagID() <= 0 {
+		err := BulkTagReviewsRequestValidationError{
+			field:  "TagID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStoreID() < 0 {
+		err := BulkTagReviewsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStatus() < 0 {
+		err := BulkTagReviewsRequestValidationError{
+			field:  "Status",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMinScore(); val < 0 || val > 5 {
+		err := BulkTagReviewsRequestValidationError{
+			field:  "MinScore",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxScore(); val < 0 || val > 5 {
+		err := BulkTagReviewsRequestValidationError{
+			field:  "MaxScore",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := BulkTagReviewsRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BulkTagReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkTagReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by BulkTagReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BulkTagReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkTagReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkTagReviewsRequestMultiError) AllErrors() []error { return m }
+
+// BulkTagReviewsRequestValidationError is the validation error returned by
+// BulkTagReviewsRequest.Validate if the designated constraints aren't met.
+type BulkTagReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkTagReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkTagReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkTagReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkTagReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkTagReviewsRequestValidationError) ErrorName() string {
+	return "BulkTagReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkTagReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkTagReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkTagReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkTagReviewsRequestValidationError{}
+
+// Validate checks the field values on BulkTagReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkTagReviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkTagReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkTagReviewsReplyMultiError, or nil if none found.
+func (m *BulkTagReviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkTagReviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return BulkTagReviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkTagReviewsReplyMultiError is an error wrapping multiple validation
+// errors returned by BulkTagReviewsReply.ValidateAll() if the designated
+// constraints aren't met.
+type BulkTagReviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkTagReviewsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkTagReviewsReplyMultiError) AllErrors() []error { return m }
+
+// BulkTagReviewsReplyValidationError is the validation error returned by
+// BulkTagReviewsReply.Validate if the designated constraints aren't met.
+type BulkTagReviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkTagReviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkTagReviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkTagReviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkTagReviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkTagReviewsReplyValidationError) ErrorName() string {
+	return "BulkTagReviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkTagReviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkTagReviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkTagReviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkTagReviewsReplyValidationError{}
+
+// Validate checks the field values on RequestWithdrawalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestWithdrawalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestWithdrawalRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestWithdrawalRequestMultiError, or nil if none found.
+func (m *RequestWithdrawalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestWithdrawalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := RequestWithdrawalRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserID() <= 0 {
+		err := RequestWithdrawalRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 2 || l > 200 {
+		err := RequestWithdrawalRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 2 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestWithdrawalRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestWithdrawalRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestWithdrawalRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RequestWithdrawalRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestWithdrawalRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestWithdrawalRequestMultiError) AllErrors() []error { return m }
+
+// RequestWithdrawalRequestValidationError is the validation error returned by
+// RequestWithdrawalRequest.Validate if the designated constraints aren't met.
+type RequestWithdrawalRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestWithdrawalRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestWithdrawalRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestWithdrawalRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestWithdrawalRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestWithdrawalRequestValidationError) ErrorName() string {
+	return "RequestWithdrawalRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestWithdrawalRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestWithdrawalRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestWithdrawalRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestWithdrawalRequestValidationError{}
+
+// Validate checks the field values on RequestWithdrawalReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestWithdrawalReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestWithdrawalReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestWithdrawalReplyMultiError, or nil if none found.
+func (m *RequestWithdrawalReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestWithdrawalReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WithdrawalID
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return RequestWithdrawalReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestWithdrawalReplyMultiError is an error wrapping multiple validation
+// errors returned by RequestWithdrawalReply.ValidateAll() if the designated
+// constraints aren't met.
+type RequestWithdrawalReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestWithdrawalReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestWithdrawalReplyMultiError) AllErrors() []error { return m }
+
+// RequestWithdrawalReplyValidationError is the validation error returned by
+// RequestWithdrawalReply.Validate if the designated constraints aren't met.
+type RequestWithdrawalReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestWithdrawalReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestWithdrawalReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestWithdrawalReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestWithdrawalReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestWithdrawalReplyValidationError) ErrorName() string {
+	return "RequestWithdrawalReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestWithdrawalReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestWithdrawalReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestWithdrawalReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestWithdrawalReplyValidationError{}
+
+// Validate checks the field values on AuditWithdrawalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditWithdrawalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditWithdrawalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditWithdrawalRequestMultiError, or nil if none found.
+func (m *AuditWithdrawalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditWithdrawalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetWithdrawalID() <= 0 {
+		err := AuditWithdrawalRequestValidationError{
+			field:  "WithdrawalID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := AuditWithdrawalRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.OpRemarks != nil {
+		// no validation rules for OpRemarks
+	}
+
+	if len(errors) > 0 {
+		return AuditWithdrawalRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditWithdrawalRequestMultiError is an error wrapping multiple validation
+// errors returned by AuditWithdrawalRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuditWithdrawalRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditWithdrawalRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditWithdrawalRequestMultiError) AllErrors() []error { return m }
+
+// AuditWithdrawalRequestValidationError is the validation error returned by
+// AuditWithdrawalRequest.Validate if the designated constraints aren't met.
+type AuditWithdrawalRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditWithdrawalRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditWithdrawalRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditWithdrawalRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditWithdrawalRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditWithdrawalRequestValidationError) ErrorName() string {
+	return "AuditWithdrawalRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditWithdrawalRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditWithdrawalRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditWithdrawalRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditWithdrawalRequestValidationError{}
+
+// Validate checks the field values on AuditWithdrawalReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditWithdrawalReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditWithdrawalReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditWithdrawalReplyMultiError, or nil if none found.
+func (m *AuditWithdrawalReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditWithdrawalReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WithdrawalID
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AuditWithdrawalReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditWithdrawalReplyMultiError is an error wrapping multiple validation
+// errors returned by AuditWithdrawalReply.ValidateAll() if the designated
+// constraints aren't met.
+type AuditWithdrawalReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditWithdrawalReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditWithdrawalReplyMultiError) AllErrors() []error { return m }
+
+// AuditWithdrawalReplyValidationError is the validation error returned by
+// AuditWithdrawalReply.Validate if the designated constraints aren't met.
+type AuditWithdrawalReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditWithdrawalReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditWithdrawalReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditWithdrawalReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditWithdrawalReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditWithdrawalReplyValidationError) ErrorName() string {
+	return "AuditWithdrawalReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditWithdrawalReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditWithdrawalReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditWithdrawalReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditWithdrawalReplyValidationError{}
+
+// Validate checks the field values on DequeueModerationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DequeueModerationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DequeueModerationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DequeueModerationRequestMultiError, or nil if none found.
+func (m *DequeueModerationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DequeueModerationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := DequeueModerationRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DequeueModerationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DequeueModerationRequestMultiError is an error wrapping multiple validation
+// errors returned by DequeueModerationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DequeueModerationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DequeueModerationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DequeueModerationRequestMultiError) AllErrors() []error { return m }
+
+// DequeueModerationRequestValidationError is the validation error returned by
+// DequeueModerationRequest.Validate if the designated constraints aren't met.
+type DequeueModerationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DequeueModerationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DequeueModerationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DequeueModerationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DequeueModerationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DequeueModerationRequestValidationError) ErrorName() string {
+	return "DequeueModerationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DequeueModerationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDequeueModerationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DequeueModerationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DequeueModerationRequestValidationError{}
+
+// Validate checks the field values on DequeueModerationReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DequeueModerationReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DequeueModerationReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DequeueModerationReplyMultiError, or nil if none found.
+func (m *DequeueModerationReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DequeueModerationReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	if len(errors) > 0 {
+		return DequeueModerationReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DequeueModerationReplyMultiError is an error wrapping multiple validation
+// errors returned by DequeueModerationReply.ValidateAll() if the designated
+// constraints aren't met.
+type DequeueModerationReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DequeueModerationReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DequeueModerationReplyMultiError) AllErrors() []error { return m }
+
+// DequeueModerationReplyValidationError is the validation error returned by
+// DequeueModerationReply.Validate if the designated constraints aren't met.
+type DequeueModerationReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DequeueModerationReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DequeueModerationReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DequeueModerationReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DequeueModerationReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DequeueModerationReplyValidationError) ErrorName() string {
+	return "DequeueModerationReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DequeueModerationReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDequeueModerationReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DequeueModerationReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DequeueModerationReplyValidationError{}
+
+// Validate checks the field values on GetModerationQueueDepthRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetModerationQueueDepthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetModerationQueueDepthRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetModerationQueueDepthRequestMultiError, or nil if none found.
+func (m *GetModerationQueueDepthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetModerationQueueDepthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := GetModerationQueueDepthRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetModerationQueueDepthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetModerationQueueDepthRequestMultiError is an error wrapping multiple
+// validation errors returned by GetModerationQueueDepthRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetModerationQueueDepthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetModerationQueueDepthRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetModerationQueueDepthRequestMultiError) AllErrors() []error { return m }
+
+// GetModerationQueueDepthRequestValidationError is the validation error
+// returned by GetModerationQueueDepthRequest.Validate if the designated
+// constraints aren't met.
+type GetModerationQueueDepthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetModerationQueueDepthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetModerationQueueDepthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetModerationQueueDepthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetModerationQueueDepthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetModerationQueueDepthRequestValidationError) ErrorName() string {
+	return "GetModerationQueueDepthRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetModerationQueueDepthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetModerationQueueDepthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetModerationQueueDepthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetModerationQueueDepthRequestValidationError{}
+
+// Validate checks the field values on GetModerationQueueDepthReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetModerationQueueDepthReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetModerationQueueDepthReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetModerationQueueDepthReplyMultiError, or nil if none found.
+func (m *GetModerationQueueDepthReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetModerationQueueDepthReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Depth
+
+	if len(errors) > 0 {
+		return GetModerationQueueDepthReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetModerationQueueDepthReplyMultiError is an error wrapping multiple
+// validation errors returned by GetModerationQueueDepthReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetModerationQueueDepthReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetModerationQueueDepthReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetModerationQueueDepthReplyMultiError) AllErrors() []error { return m }
+
+// GetModerationQueueDepthReplyValidationError is the validation error returned
+// by GetModerationQueueDepthReply.Validate if the designated constraints
+// aren't met.
+type GetModerationQueueDepthReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetModerationQueueDepthReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetModerationQueueDepthReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetModerationQueueDepthReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetModerationQueueDepthReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetModerationQueueDepthReplyValidationError) ErrorName() string {
+	return "GetModerationQueueDepthReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetModerationQueueDepthReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetModerationQueueDepthReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetModerationQueueDepthReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetModerationQueueDepthReplyValidationError{}
+
+// Validate checks the field values on BulkGetReviewStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkGetReviewStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkGetReviewStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkGetReviewStatsRequestMultiError, or nil if none found.
+func (m *BulkGetReviewStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkGetReviewStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetProductIDs()); l < 1 || l > 200 {
+		err := BulkGetReviewStatsRequestValidationError{
+			field:  "ProductIDs",
+			reason: "value must contain between 1 and 200 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetProductIDs() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := BulkGetReviewStatsRequestValidationError{
+				field:  fmt.Sprintf("ProductIDs[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BulkGetReviewStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkGetReviewStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by BulkGetReviewStatsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type BulkGetReviewStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkGetReviewStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkGetReviewStatsRequestMultiError) AllErrors() []error { return m }
+
+// BulkGetReviewStatsRequestValidationError is the validation error returned by
+// BulkGetReviewStatsRequest.Validate if the designated constraints aren't met.
+type BulkGetReviewStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkGetReviewStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkGetReviewStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkGetReviewStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkGetReviewStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkGetReviewStatsRequestValidationError) ErrorName() string {
+	return "BulkGetReviewStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkGetReviewStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkGetReviewStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkGetReviewStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkGetReviewStatsRequestValidationError{}
+
+// Validate checks the field values on ProductStat with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProductStat) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProductStat with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProductStatMultiError, or
+// nil if none found.
+func (m *ProductStat) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProductStat) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AvgScore
+
+	// no validation rules for ReviewCount
+
+	// no validation rules for HasVerifiedPurchase
+
+	if len(errors) > 0 {
+		return ProductStatMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProductStatMultiError is an error wrapping multiple validation errors
+// returned by ProductStat.ValidateAll() if the designated constraints aren't met.
+type ProductStatMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProductStatMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProductStatMultiError) AllErrors() []error { return m }
+
+// ProductStatValidationError is the validation error returned by
+// ProductStat.Validate if the designated constraints aren't met.
+type ProductStatValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProductStatValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProductStatValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProductStatValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProductStatValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProductStatValidationError) ErrorName() string { return "ProductStatValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProductStatValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProductStat.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProductStatValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProductStatValidationError{}
+
+// Validate checks the field values on BulkGetReviewStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkGetReviewStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkGetReviewStatsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkGetReviewStatsReplyMultiError, or nil if none found.
+func (m *BulkGetReviewStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkGetReviewStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]int64, len(m.GetStats()))
+		i := 0
+		for key := range m.GetStats() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetStats()[key]
+			_ = val
+
+			// no validation rules for Stats[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, BulkGetReviewStatsReplyValidationError{
+							field:  fmt.Sprintf("Stats[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, BulkGetReviewStatsReplyValidationError{
+							field:  fmt.Sprintf("Stats[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return BulkGetReviewStatsReplyValidationError{
+						field:  fmt.Sprintf("Stats[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return BulkGetReviewStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkGetReviewStatsReplyMultiError is an error wrapping multiple validation
+// errors returned by BulkGetReviewStatsReply.ValidateAll() if the designated
+// constraints aren't met.
+type BulkGetReviewStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkGetReviewStatsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkGetReviewStatsReplyMultiError) AllErrors() []error { return m }
+
+// BulkGetReviewStatsReplyValidationError is the validation error returned by
+// BulkGetReviewStatsReply.Validate if the designated constraints aren't met.
+type BulkGetReviewStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkGetReviewStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkGetReviewStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkGetReviewStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkGetReviewStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkGetReviewStatsReplyValidationError) ErrorName() string {
+	return "BulkGetReviewStatsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkGetReviewStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkGetReviewStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkGetReviewStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkGetReviewStatsReplyValidationError{}
+
+// Validate checks the field values on GetPlatformReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPlatformReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPlatformReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPlatformReportRequestMultiError, or nil if none found.
+func (m *GetPlatformReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPlatformReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFrom() <= 0 {
+		err := GetPlatformReportRequestValidationError{
+			field:  "From",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTo() <= 0 {
+		err := GetPlatformReportRequestValidationError{
+			field:  "To",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPlatformReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPlatformReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPlatformReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPlatformReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPlatformReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPlatformReportRequestMultiError) AllErrors() []error { return m }
+
+// GetPlatformReportRequestValidationError is the validation error returned by
+// GetPlatformReportRequest.Validate if the designated constraints aren't met.
+type GetPlatformReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPlatformReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPlatformReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPlatformReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPlatformReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPlatformReportRequestValidationError) ErrorName() string {
+	return "GetPlatformReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPlatformReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPlatformReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPlatformReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPlatformReportRequestValidationError{}
+
+// Validate checks the field values on GetPlatformReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPlatformReportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPlatformReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPlatformReportReplyMultiError, or nil if none found.
+func (m *GetPlatformReportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPlatformReportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalReviews
+
+	// no validation rules for AvgScore
+
+	// no validation rules for ApprovalRate
+
+	// no validation rules for MostActiveStore
+
+	// no validation rules for DauReviewers
+
+	if len(errors) > 0 {
+		return GetPlatformReportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPlatformReportReplyMultiError is an error wrapping multiple validation
+// errors returned by GetPlatformReportReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetPlatformReportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPlatformReportReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPlatformReportReplyMultiError) AllErrors() []error { return m }
+
+// GetPlatformReportReplyValidationError is the validation error returned by
+// GetPlatformReportReply.Validate if the designated constraints aren't met.
+type GetPlatformReportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPlatformReportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPlatformReportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPlatformReportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPlatformReportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPlatformReportReplyValidationError) ErrorName() string {
+	return "GetPlatformReportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPlatformReportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPlatformReportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPlatformReportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPlatformReportReplyValidationError{}
+
+// Validate checks the field values on ListAnomalousReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAnomalousReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAnomalousReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAnomalousReviewsRequestMultiError, or nil if none found.
+func (m *ListAnomalousReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAnomalousReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListAnomalousReviewsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetThreshold(); val < 0 || val > 1 {
+		err := ListAnomalousReviewsRequestValidationError{
+			field:  "Threshold",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListAnomalousReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAnomalousReviewsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListAnomalousReviewsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListAnomalousReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAnomalousReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAnomalousReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ListAnomalousReviewsRequestValidationError is the validation error returned
+// by ListAnomalousReviewsRequest.Validate if the designated constraints
+// aren't met.
+type ListAnomalousReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAnomalousReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAnomalousReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAnomalousReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAnomalousReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAnomalousReviewsRequestValidationError) ErrorName() string {
+	return "ListAnomalousReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAnomalousReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAnomalousReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAnomalousReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAnomalousReviewsRequestValidationError{}
+
+// Validate checks the field values on ListAnomalousReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAnomalousReviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAnomalousReviewsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAnomalousReviewsReplyMultiError, or nil if none found.
+func (m *ListAnomalousReviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAnomalousReviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAnomalousReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAnomalousReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAnomalousReviewsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAnomalousReviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAnomalousReviewsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListAnomalousReviewsReply.ValidateAll() if the
+// designated constraints aren't met.
+type ListAnomalousReviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAnomalousReviewsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAnomalousReviewsReplyMultiError) AllErrors() []error { return m }
+
+// ListAnomalousReviewsReplyValidationError is the validation error returned by
+// ListAnomalousReviewsReply.Validate if the designated constraints aren't met.
+type ListAnomalousReviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAnomalousReviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAnomalousReviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAnomalousReviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAnomalousReviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAnomalousReviewsReplyValidationError) ErrorName() string {
+	return "ListAnomalousReviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAnomalousReviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAnomalousReviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAnomalousReviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAnomalousReviewsReplyValidationError{}
+
+// Validate checks the field values on DateRange with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DateRange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DateRange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DateRangeMultiError, or nil
+// if none found.
+func (m *DateRange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DateRange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFrom() <= 0 {
+		err := DateRangeValidationError{
+			field:  "From",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTo() <= 0 {
+		err := DateRangeValidationError{
+			field:  "To",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DateRangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// DateRangeMultiError is an error wrapping multiple validation errors returned
+// by DateRange.ValidateAll() if the designated constraints aren't met.
+type DateRangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DateRangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DateRangeMultiError) AllErrors() []error { return m }
+
+// DateRangeValidationError is the validation error returned by
+// DateRange.Validate if the designated constraints aren't met.
+type DateRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DateRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DateRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DateRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DateRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DateRangeValidationError) ErrorName() string { return "DateRangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DateRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDateRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DateRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DateRangeValidationError{}
+
+// Validate checks the field values on CompareReviewPeriodsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompareReviewPeriodsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompareReviewPeriodsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompareReviewPeriodsRequestMultiError, or nil if none found.
+func (m *CompareReviewPeriodsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompareReviewPeriodsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := CompareReviewPeriodsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPeriodA() == nil {
+		err := CompareReviewPeriodsRequestValidationError{
+			field:  "PeriodA",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPeriodA()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompareReviewPeriodsRequestValidationError{
+					field:  "PeriodA",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompareReviewPeriodsRequestValidationError{
+					field:  "PeriodA",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodA()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompareReviewPeriodsRequestValidationError{
+				field:  "PeriodA",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPeriodB() == nil {
+		err := CompareReviewPeriodsRequestValidationError{
+			field:  "PeriodB",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPeriodB()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompareReviewPeriodsRequestValidationError{
+					field:  "PeriodB",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompareReviewPeriodsRequestValidationError{
+					field:  "PeriodB",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodB()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompareReviewPeriodsRequestValidationError{
+				field:  "PeriodB",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CompareReviewPeriodsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompareReviewPeriodsRequestMultiError is an error wrapping multiple
+// validation errors returned by CompareReviewPeriodsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CompareReviewPeriodsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompareReviewPeriodsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompareReviewPeriodsRequestMultiError) AllErrors() []error { return m }
+
+// CompareReviewPeriodsRequestValidationError is the validation error returned
+// by CompareReviewPeriodsRequest.Validate if the designated constraints
+// aren't met.
+type CompareReviewPeriodsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompareReviewPeriodsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompareReviewPeriodsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompareReviewPeriodsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompareReviewPeriodsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompareReviewPeriodsRequestValidationError) ErrorName() string {
+	return "CompareReviewPeriodsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompareReviewPeriodsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompareReviewPeriodsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompareReviewPeriodsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompareReviewPeriodsRequestValidationError{}
+
+// Validate checks the field values on PeriodStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PeriodStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PeriodStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PeriodStatsMultiError, or
+// nil if none found.
+func (m *PeriodStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PeriodStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AvgScore
+
+	// no validation rules for ReviewCount
+
+	if len(errors) > 0 {
+		return PeriodStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PeriodStatsMultiError is an error wrapping multiple validation errors
+// returned by PeriodStats.ValidateAll() if the designated constraints aren't met.
+type PeriodStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PeriodStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PeriodStatsMultiError) AllErrors() []error { return m }
+
+// PeriodStatsValidationError is the validation error returned by
+// PeriodStats.Validate if the designated constraints aren't met.
+type PeriodStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeriodStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeriodStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeriodStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeriodStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeriodStatsValidationError) ErrorName() string { return "PeriodStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PeriodStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeriodStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeriodStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeriodStatsValidationError{}
+
+// Validate checks the field values on CompareReviewPeriodsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompareReviewPeriodsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompareReviewPeriodsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompareReviewPeriodsReplyMultiError, or nil if none found.
+func (m *CompareReviewPeriodsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompareReviewPeriodsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPeriodA()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompareReviewPeriodsReplyValidationError{
+					field:  "PeriodA",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompareReviewPeriodsReplyValidationError{
+					field:  "PeriodA",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodA()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompareReviewPeriodsReplyValidationError{
+				field:  "PeriodA",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPeriodB()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompareReviewPeriodsReplyValidationError{
+					field:  "PeriodB",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompareReviewPeriodsReplyValidationError{
+					field:  "PeriodB",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodB()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompareReviewPeriodsReplyValidationError{
+				field:  "PeriodB",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CompareReviewPeriodsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompareReviewPeriodsReplyMultiError is an error wrapping multiple validation
+// errors returned by CompareReviewPeriodsReply.ValidateAll() if the
+// designated constraints aren't met.
+type CompareReviewPeriodsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompareReviewPeriodsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompareReviewPeriodsReplyMultiError) AllErrors() []error { return m }
+
+// CompareReviewPeriodsReplyValidationError is the validation error returned by
+// CompareReviewPeriodsReply.Validate if the designated constraints aren't met.
+type CompareReviewPeriodsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompareReviewPeriodsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompareReviewPeriodsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompareReviewPeriodsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompareReviewPeriodsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompareReviewPeriodsReplyValidationError) ErrorName() string {
+	return "CompareReviewPeriodsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompareReviewPeriodsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompareReviewPeriodsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompareReviewPeriodsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompareReviewPeriodsReplyValidationError{}
+
+// Validate checks the field values on ExportUserDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportUserDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportUserDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportUserDataRequestMultiError, or nil if none found.
+func (m *ExportUserDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportUserDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := ExportUserDataRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportUserDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportUserDataRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportUserDataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportUserDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportUserDataRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportUserDataRequestMultiError) AllErrors() []error { return m }
+
+// ExportUserDataRequestValidationError is the validation error returned by
+// ExportUserDataRequest.Validate if the designated constraints aren't met.
+type ExportUserDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportUserDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportUserDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportUserDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportUserDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportUserDataRequestValidationError) ErrorName() string {
+	return "ExportUserDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportUserDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportUserDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportUserDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportUserDataRequestValidationError{}
+
+// Validate checks the field values on ExportUserDataReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportUserDataReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportUserDataReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportUserDataReplyMultiError, or nil if none found.
+func (m *ExportUserDataReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportUserDataReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for Signature
+
+	if len(errors) > 0 {
+		return ExportUserDataReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportUserDataReplyMultiError is an error wrapping multiple validation
+// errors returned by ExportUserDataReply.ValidateAll() if the designated
+// constraints aren't met.
+type ExportUserDataReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportUserDataReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportUserDataReplyMultiError) AllErrors() []error { return m }
+
+// ExportUserDataReplyValidationError is the validation error returned by
+// ExportUserDataReply.Validate if the designated constraints aren't met.
+type ExportUserDataReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportUserDataReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportUserDataReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportUserDataReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportUserDataReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportUserDataReplyValidationError) ErrorName() string {
+	return "ExportUserDataReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportUserDataReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportUserDataReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportUserDataReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportUserDataReplyValidationError{}
+
+// Validate checks the field values on DetectTrendingTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DetectTrendingTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DetectTrendingTagsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DetectTrendingTagsRequestMultiError, or nil if none found.
+func (m *DetectTrendingTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DetectTrendingTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := DetectTrendingTagsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetTopN(); val < 1 || val > 100 {
+		err := DetectTrendingTagsRequestValidationError{
+			field:  "TopN",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DetectTrendingTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DetectTrendingTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by DetectTrendingTagsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DetectTrendingTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DetectTrendingTagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DetectTrendingTagsRequestMultiError) AllErrors() []error { return m }
+
+// DetectTrendingTagsRequestValidationError is the validation error returned by
+// DetectTrendingTagsRequest.Validate if the designated constraints aren't met.
+type DetectTrendingTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DetectTrendingTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DetectTrendingTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DetectTrendingTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DetectTrendingTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DetectTrendingTagsRequestValidationError) ErrorName() string {
+	return "DetectTrendingTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DetectTrendingTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDetectTrendingTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DetectTrendingTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DetectTrendingTagsRequestValidationError{}
+
+// Validate checks the field values on TrendingTag with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrendingTag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrendingTag with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrendingTagMultiError, or
+// nil if none found.
+func (m *TrendingTag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrendingTag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TagID
+
+	// no validation rules for Name
+
+	// no validation rules for TodayCount
+
+	// no validation rules for WeeklyAvg
+
+	// no validation rules for TrendScore
+
+	if len(errors) > 0 {
+		return TrendingTagMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrendingTagMultiError is an error wrapping multiple validation errors
+// returned by TrendingTag.ValidateAll() if the designated constraints aren't met.
+type TrendingTagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrendingTagMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrendingTagMultiError) AllErrors() []error { return m }
+
+// TrendingTagValidationError is the validation error returned by
+// TrendingTag.Validate if the designated constraints aren't met.
+type TrendingTagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrendingTagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrendingTagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrendingTagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrendingTagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrendingTagValidationError) ErrorName() string { return "TrendingTagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrendingTagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrendingTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrendingTagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrendingTagValidationError{}
+
+// Validate checks the field values on DetectTrendingTagsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DetectTrendingTagsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DetectTrendingTagsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DetectTrendingTagsReplyMultiError, or nil if none found.
+func (m *DetectTrendingTagsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DetectTrendingTagsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DetectTrendingTagsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DetectTrendingTagsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DetectTrendingTagsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DetectTrendingTagsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DetectTrendingTagsReplyMultiError is an error wrapping multiple validation
+// errors returned by DetectTrendingTagsReply.ValidateAll() if the designated
+// constraints aren't met.
+type DetectTrendingTagsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DetectTrendingTagsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DetectTrendingTagsReplyMultiError) AllErrors() []error { return m }
+
+// DetectTrendingTagsReplyValidationError is the validation error returned by
+// DetectTrendingTagsReply.Validate if the designated constraints aren't met.
+type DetectTrendingTagsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DetectTrendingTagsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DetectTrendingTagsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DetectTrendingTagsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DetectTrendingTagsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DetectTrendingTagsReplyValidationError) ErrorName() string {
+	return "DetectTrendingTagsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DetectTrendingTagsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDetectTrendingTagsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DetectTrendingTagsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DetectTrendingTagsReplyValidationError{}
+
+// Validate checks the field values on StartReviewSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartReviewSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartReviewSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartReviewSessionRequestMultiError, or nil if none found.
+func (m *StartReviewSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartReviewSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := StartReviewSessionRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOrderID() <= 0 {
+		err := StartReviewSessionRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStoreID() <= 0 {
+		err := StartReviewSessionRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSpuID() <= 0 {
+		err := StartReviewSessionRequestValidationError{
+			field:  "SpuID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSkuID() <= 0 {
+		err := StartReviewSessionRequestValidationError{
+			field:  "SkuID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StartReviewSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartReviewSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by StartReviewSessionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type StartReviewSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartReviewSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartReviewSessionRequestMultiError) AllErrors() []error { return m }
+
+// StartReviewSessionRequestValidationError is the validation error returned by
+// StartReviewSessionRequest.Validate if the designated constraints aren't met.
+type StartReviewSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartReviewSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartReviewSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartReviewSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartReviewSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartReviewSessionRequestValidationError) ErrorName() string {
+	return "StartReviewSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartReviewSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartReviewSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartReviewSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartReviewSessionRequestValidationError{}
+
+// Validate checks the field values on StartReviewSessionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartReviewSessionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartReviewSessionReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartReviewSessionReplyMultiError, or nil if none found.
+func (m *StartReviewSessionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartReviewSessionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionID
+
+	if len(errors) > 0 {
+		return StartReviewSessionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartReviewSessionReplyMultiError is an error wrapping multiple validation
+// errors returned by StartReviewSessionReply.ValidateAll() if the designated
+// constraints aren't met.
+type StartReviewSessionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartReviewSessionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartReviewSessionReplyMultiError) AllErrors() []error { return m }
+
+// StartReviewSessionReplyValidationError is the validation error returned by
+// StartReviewSessionReply.Validate if the designated constraints aren't met.
+type StartReviewSessionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartReviewSessionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartReviewSessionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartReviewSessionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartReviewSessionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartReviewSessionReplyValidationError) ErrorName() string {
+	return "StartReviewSessionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartReviewSessionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartReviewSessionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartReviewSessionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartReviewSessionReplyValidationError{}
+
+// Validate checks the field values on UpdateReviewSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewSessionRequestMultiError, or nil if none found.
+func (m *UpdateReviewSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSessionID()) < 1 {
+		err := UpdateReviewSessionRequestValidationError{
+			field:  "SessionID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserID() <= 0 {
+		err := UpdateReviewSessionRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateReviewSessionRequest_Step_InLookup[m.GetStep()]; !ok {
+		err := UpdateReviewSessionRequestValidationError{
+			field:  "Step",
+			reason: "value must be in list [1 2 3]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateReviewSessionRequestValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateReviewSessionRequestValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateReviewSessionRequestValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateReviewSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewSessionRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateReviewSessionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateReviewSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewSessionRequestMultiError) AllErrors() []error { return m }
+
+// UpdateReviewSessionRequestValidationError is the validation error returned
+// by UpdateReviewSessionRequest.Validate if the designated constraints aren't met.
+type UpdateReviewSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewSessionRequestValidationError) ErrorName() string {
+	return "UpdateReviewSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewSessionRequestValidationError{}
+
+var _UpdateReviewSessionRequest_Step_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+}
+
+// Validate checks the field values on UpdateReviewSessionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewSessionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewSessionReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewSessionReplyMultiError, or nil if none found.
+func (m *UpdateReviewSessionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewSessionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateReviewSessionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewSessionReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdateReviewSessionReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReviewSessionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewSessionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewSessionReplyMultiError) AllErrors() []error { return m }
+
+// UpdateReviewSessionReplyValidationError is the validation error returned by
+// UpdateReviewSessionReply.Validate if the designated constraints aren't met.
+type UpdateReviewSessionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewSessionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewSessionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewSessionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewSessionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewSessionReplyValidationError) ErrorName() string {
+	return "UpdateReviewSessionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewSessionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewSessionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewSessionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewSessionReplyValidationError{}
+
+// Validate checks the field values on CommitReviewSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitReviewSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitReviewSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitReviewSessionRequestMultiError, or nil if none found.
+func (m *CommitReviewSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitReviewSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSessionID()) < 1 {
+		err := CommitReviewSessionRequestValidationError{
+			field:  "SessionID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserID() <= 0 {
+		err := CommitReviewSessionRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CommitReviewSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitReviewSessionRequestMultiError is an error wrapping multiple
+// validation errors returned by CommitReviewSessionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CommitReviewSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitReviewSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitReviewSessionRequestMultiError) AllErrors() []error { return m }
+
+// CommitReviewSessionRequestValidationError is the validation error returned
+// by CommitReviewSessionRequest.Validate if the designated constraints aren't met.
+type CommitReviewSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitReviewSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitReviewSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitReviewSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitReviewSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitReviewSessionRequestValidationError) ErrorName() string {
+	return "CommitReviewSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitReviewSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitReviewSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitReviewSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitReviewSessionRequestValidationError{}
+
+// Validate checks the field values on CommitReviewSessionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitReviewSessionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitReviewSessionReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitReviewSessionReplyMultiError, or nil if none found.
+func (m *CommitReviewSessionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitReviewSessionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	if len(errors) > 0 {
+		return CommitReviewSessionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitReviewSessionReplyMultiError is an error wrapping multiple validation
+// errors returned by CommitReviewSessionReply.ValidateAll() if the designated
+// constraints aren't met.
+type CommitReviewSessionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitReviewSessionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitReviewSessionReplyMultiError) AllErrors() []error { return m }
+
+// CommitReviewSessionReplyValidationError is the validation error returned by
+// CommitReviewSessionReply.Validate if the designated constraints aren't met.
+type CommitReviewSessionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitReviewSessionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitReviewSessionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitReviewSessionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitReviewSessionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitReviewSessionReplyValidationError) ErrorName() string {
+	return "CommitReviewSessionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitReviewSessionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitReviewSessionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitReviewSessionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitReviewSessionReplyValidationError{}
+
+// Validate checks the field values on ListReviewByStoreIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewByStoreIDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewByStoreIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewByStoreIDRequestMultiError, or nil if none found.
+func (m *ListReviewByStoreIDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewByStoreIDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListReviewByStoreIDRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStatus() < 0 {
+		err := ListReviewByStoreIDRequestValidationError{
+			field:  "Status",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListReviewByStoreIDRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetSize(); val <= 0 || val > 100 {
+		err := ListReviewByStoreIDRequestValidationError{
+			field:  "Size",
+			reason: "value must be inside range (0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListReviewByStoreIDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewByStoreIDRequestMultiError is an error wrapping multiple
+// validation errors returned by ListReviewByStoreIDRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListReviewByStoreIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewByStoreIDRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewByStoreIDRequestMultiError) AllErrors() []error { return m }
+
+// ListReviewByStoreIDRequestValidationError is the validation error returned
+// by ListReviewByStoreIDRequest.Validate if the designated constraints aren't met.
+type ListReviewByStoreIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewByStoreIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewByStoreIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewByStoreIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewByStoreIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewByStoreIDRequestValidationError) ErrorName() string {
+	return "ListReviewByStoreIDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewByStoreIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewByStoreIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewByStoreIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewByStoreIDRequestValidationError{}
+
+// Validate checks the field values on ListReviewByStoreIDReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewByStoreIDReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewByStoreIDReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewByStoreIDReplyMultiError, or nil if none found.
+func (m *ListReviewByStoreIDReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewByStoreIDReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewByStoreIDReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewByStoreIDReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewByStoreIDReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListReviewByStoreIDReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewByStoreIDReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReviewByStoreIDReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewByStoreIDReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewByStoreIDReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewByStoreIDReplyMultiError) AllErrors() []error { return m }
+
+// ListReviewByStoreIDReplyValidationError is the validation error returned by
+// ListReviewByStoreIDReply.Validate if the designated constraints aren't met.
+type ListReviewByStoreIDReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewByStoreIDReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewByStoreIDReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewByStoreIDReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewByStoreIDReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewByStoreIDReplyValidationError) ErrorName() string {
+	return "ListReviewByStoreIDReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewByStoreIDReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewByStoreIDReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewByStoreIDReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewByStoreIDReplyValidationError{}
@@ -48,3 +48,89 @@ func IsOrderReviewed(err error) bool {
 func ErrorOrderReviewed(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, ErrorReason_ORDER_REVIEWED.String(), fmt.Sprintf(format, args...))
 }
+
+// 已废弃：标签不存在统一返回RESOURCE_NOT_FOUND，保留编号避免被其他错误复用
+func IsTagNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TAG_NOT_FOUND.String() && e.Code == 404
+}
+
+// 已废弃：标签不存在统一返回RESOURCE_NOT_FOUND，保留编号避免被其他错误复用
+func ErrorTagNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_TAG_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsReviewSessionNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_REVIEW_SESSION_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorReviewSessionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_REVIEW_SESSION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsDuplicateContent(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_DUPLICATE_CONTENT.String() && e.Code == 400
+}
+
+func ErrorDuplicateContent(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_DUPLICATE_CONTENT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsWithdrawalNotAllowed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_WITHDRAWAL_NOT_ALLOWED.String() && e.Code == 400
+}
+
+func ErrorWithdrawalNotAllowed(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_WITHDRAWAL_NOT_ALLOWED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsWithdrawalRequested(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_WITHDRAWAL_REQUESTED.String() && e.Code == 400
+}
+
+func ErrorWithdrawalRequested(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_WITHDRAWAL_REQUESTED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsReviewNotAuditable(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_REVIEW_NOT_AUDITABLE.String() && e.Code == 400
+}
+
+func ErrorReviewNotAuditable(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_REVIEW_NOT_AUDITABLE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsContentTooShort(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_CONTENT_TOO_SHORT.String() && e.Code == 400
+}
+
+func ErrorContentTooShort(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_CONTENT_TOO_SHORT.String(), fmt.Sprintf(format, args...))
+}
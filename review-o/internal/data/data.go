@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"os"
 	v1 "review-o/api/review/v1"
 	"review-o/internal/conf"
 
@@ -13,6 +14,14 @@ import (
 	"github.com/google/wire"
 
 	consulAPI "github.com/hashicorp/consul/api"
+	ggrpc "google.golang.org/grpc"
+	grpcmd "google.golang.org/grpc/metadata"
+)
+
+// 审核评价是评价服务的管理接口，需要带上与评价服务相同的管理密钥
+const (
+	adminKeyEnv    = "ADMIN_KEY"
+	adminKeyHeader = "x-admin-key"
 )
 
 // ProviderSet is data providers.
@@ -53,9 +62,19 @@ func NewReviewServiceClient(d registry.Discovery) v1.ReviewClient {
 		grpc.WithMiddleware(
 			recovery.Recovery(),
 		),
+		grpc.WithUnaryInterceptor(adminKeyInterceptor(os.Getenv(adminKeyEnv))),
 	)
 	if err != nil {
 		panic(err)
 	}
 	return v1.NewReviewClient(conn)
 }
+
+func adminKeyInterceptor(key string) ggrpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *ggrpc.ClientConn, invoker ggrpc.UnaryInvoker, opts ...ggrpc.CallOption) error {
+		if key != "" {
+			ctx = grpcmd.AppendToOutgoingContext(ctx, adminKeyHeader, key)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,9 @@
+// Package openapi 评价服务的Swagger(OpenAPI v2)文档，由protoc-gen-openapiv2根据review.proto生成，执行make api更新
+package openapi
+
+import _ "embed"
+
+// ReviewSwagger review.swagger.json的内容
+//
+//go:embed review.swagger.json
+var ReviewSwagger []byte
@@ -28,6 +28,14 @@ const (
 	ErrorReason_NEED_LOGIN     ErrorReason = 0
 	ErrorReason_DB_FAILED      ErrorReason = 1
 	ErrorReason_ORDER_REVIEWED ErrorReason = 100
+	// 已废弃：标签不存在统一返回RESOURCE_NOT_FOUND，保留编号避免被其他错误复用
+	ErrorReason_TAG_NOT_FOUND            ErrorReason = 101
+	ErrorReason_REVIEW_SESSION_NOT_FOUND ErrorReason = 102
+	ErrorReason_DUPLICATE_CONTENT        ErrorReason = 103
+	ErrorReason_WITHDRAWAL_NOT_ALLOWED   ErrorReason = 104
+	ErrorReason_WITHDRAWAL_REQUESTED     ErrorReason = 105
+	ErrorReason_REVIEW_NOT_AUDITABLE     ErrorReason = 106
+	ErrorReason_CONTENT_TOO_SHORT        ErrorReason = 107
 )
 
 // Enum value maps for ErrorReason.
@@ -36,11 +44,25 @@ var (
 		0:   "NEED_LOGIN",
 		1:   "DB_FAILED",
 		100: "ORDER_REVIEWED",
+		101: "TAG_NOT_FOUND",
+		102: "REVIEW_SESSION_NOT_FOUND",
+		103: "DUPLICATE_CONTENT",
+		104: "WITHDRAWAL_NOT_ALLOWED",
+		105: "WITHDRAWAL_REQUESTED",
+		106: "REVIEW_NOT_AUDITABLE",
+		107: "CONTENT_TOO_SHORT",
 	}
 	ErrorReason_value = map[string]int32{
-		"NEED_LOGIN":     0,
-		"DB_FAILED":      1,
-		"ORDER_REVIEWED": 100,
+		"NEED_LOGIN":               0,
+		"DB_FAILED":                1,
+		"ORDER_REVIEWED":           100,
+		"TAG_NOT_FOUND":            101,
+		"REVIEW_SESSION_NOT_FOUND": 102,
+		"DUPLICATE_CONTENT":        103,
+		"WITHDRAWAL_NOT_ALLOWED":   104,
+		"WITHDRAWAL_REQUESTED":     105,
+		"REVIEW_NOT_AUDITABLE":     106,
+		"CONTENT_TOO_SHORT":        107,
 	}
 )
 
@@ -78,16 +100,30 @@ var file_api_review_v1_review_error_proto_rawDesc = []byte{
 	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x0d, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76,
 	0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x58, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x0a, 0x4e, 0x45, 0x45, 0x44, 0x5f, 0x4c, 0x4f,
-	0x47, 0x49, 0x4e, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x13, 0x0a, 0x09, 0x44,
-	0x42, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03,
-	0x12, 0x18, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57,
-	0x45, 0x44, 0x10, 0x64, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03,
-	0x42, 0x32, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76,
-	0x31, 0x50, 0x01, 0x5a, 0x1f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2d, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x76,
-	0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xb1, 0x02, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x0a, 0x4e, 0x45, 0x45, 0x44, 0x5f, 0x4c,
+	0x4f, 0x47, 0x49, 0x4e, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x13, 0x0a, 0x09,
+	0x44, 0x42, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0xf4,
+	0x03, 0x12, 0x18, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45,
+	0x57, 0x45, 0x44, 0x10, 0x64, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x17, 0x0a, 0x0d, 0x54,
+	0x41, 0x47, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x65, 0x1a, 0x04,
+	0xa8, 0x45, 0x94, 0x03, 0x12, 0x22, 0x0a, 0x18, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53,
+	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x66, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x44, 0x55, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x67, 0x1a,
+	0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x20, 0x0a, 0x16, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41,
+	0x57, 0x41, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10,
+	0x68, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x14, 0x57, 0x49, 0x54, 0x48, 0x44,
+	0x52, 0x41, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10,
+	0x69, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x14, 0x52, 0x45, 0x56, 0x49, 0x45,
+	0x57, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x6a, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x54, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x6b, 0x1a, 0x04,
+	0xa8, 0x45, 0x90, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x32, 0x0a, 0x0d, 0x61, 0x70,
+	0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x1f, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -19,13 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Review_CreateReview_FullMethodName       = "/api.review.v1.Review/CreateReview"
-	Review_GetReview_FullMethodName          = "/api.review.v1.Review/GetReview"
-	Review_AuditReview_FullMethodName        = "/api.review.v1.Review/AuditReview"
-	Review_ReplyReview_FullMethodName        = "/api.review.v1.Review/ReplyReview"
-	Review_AppealReview_FullMethodName       = "/api.review.v1.Review/AppealReview"
-	Review_AuditAppeal_FullMethodName        = "/api.review.v1.Review/AuditAppeal"
-	Review_ListReviewByUserID_FullMethodName = "/api.review.v1.Review/ListReviewByUserID"
+	Review_CreateReview_FullMethodName            = "/api.review.v1.Review/CreateReview"
+	Review_GetReview_FullMethodName               = "/api.review.v1.Review/GetReview"
+	Review_AuditReview_FullMethodName             = "/api.review.v1.Review/AuditReview"
+	Review_ReplyReview_FullMethodName             = "/api.review.v1.Review/ReplyReview"
+	Review_AppealReview_FullMethodName            = "/api.review.v1.Review/AppealReview"
+	Review_AuditAppeal_FullMethodName             = "/api.review.v1.Review/AuditAppeal"
+	Review_ListReviewByUserID_FullMethodName      = "/api.review.v1.Review/ListReviewByUserID"
+	Review_BulkTagReviews_FullMethodName          = "/api.review.v1.Review/BulkTagReviews"
+	Review_RequestWithdrawal_FullMethodName       = "/api.review.v1.Review/RequestWithdrawal"
+	Review_ApproveWithdrawal_FullMethodName       = "/api.review.v1.Review/ApproveWithdrawal"
+	Review_DenyWithdrawal_FullMethodName          = "/api.review.v1.Review/DenyWithdrawal"
+	Review_DequeueModeration_FullMethodName       = "/api.review.v1.Review/DequeueModeration"
+	Review_GetModerationQueueDepth_FullMethodName = "/api.review.v1.Review/GetModerationQueueDepth"
+	Review_BulkGetReviewStats_FullMethodName      = "/api.review.v1.Review/BulkGetReviewStats"
+	Review_GetPlatformReport_FullMethodName       = "/api.review.v1.Review/GetPlatformReport"
+	Review_ListAnomalousReviews_FullMethodName    = "/api.review.v1.Review/ListAnomalousReviews"
+	Review_CompareReviewPeriods_FullMethodName    = "/api.review.v1.Review/CompareReviewPeriods"
+	Review_ExportUserData_FullMethodName          = "/api.review.v1.Review/ExportUserData"
+	Review_DetectTrendingTags_FullMethodName      = "/api.review.v1.Review/DetectTrendingTags"
+	Review_StartReviewSession_FullMethodName      = "/api.review.v1.Review/StartReviewSession"
+	Review_UpdateReviewSession_FullMethodName     = "/api.review.v1.Review/UpdateReviewSession"
+	Review_CommitReviewSession_FullMethodName     = "/api.review.v1.Review/CommitReviewSession"
+	Review_ListReviewByStoreID_FullMethodName     = "/api.review.v1.Review/ListReviewByStoreID"
 )
 
 // ReviewClient is the client API for Review service.
@@ -36,7 +52,7 @@ type ReviewClient interface {
 	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewReply, error)
 	// C端获取评价详情
 	GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewReply, error)
-	// O端审核评价
+	// O端审核评价，需要管理密钥
 	AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...grpc.CallOption) (*AuditReviewReply, error)
 	// B端回复评价
 	ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...grpc.CallOption) (*ReplyReviewReply, error)
@@ -46,6 +62,39 @@ type ReviewClient interface {
 	AuditAppeal(ctx context.Context, in *AuditAppealRequest, opts ...grpc.CallOption) (*AuditAppealReply, error)
 	// C端查看userID下所有评价
 	ListReviewByUserID(ctx context.Context, in *ListReviewByUserIDRequest, opts ...grpc.CallOption) (*ListReviewByUserIDReply, error)
+	// O端按条件批量给评价打标签
+	BulkTagReviews(ctx context.Context, in *BulkTagReviewsRequest, opts ...grpc.CallOption) (*BulkTagReviewsReply, error)
+	// C端申请撤回已审核通过的评价
+	RequestWithdrawal(ctx context.Context, in *RequestWithdrawalRequest, opts ...grpc.CallOption) (*RequestWithdrawalReply, error)
+	// O端同意撤回评价
+	ApproveWithdrawal(ctx context.Context, in *AuditWithdrawalRequest, opts ...grpc.CallOption) (*AuditWithdrawalReply, error)
+	// O端拒绝撤回评价
+	DenyWithdrawal(ctx context.Context, in *AuditWithdrawalRequest, opts ...grpc.CallOption) (*AuditWithdrawalReply, error)
+	// O端从店铺的待审核队列中取出最紧急的一条评价
+	DequeueModeration(ctx context.Context, in *DequeueModerationRequest, opts ...grpc.CallOption) (*DequeueModerationReply, error)
+	// O端查看店铺待审核队列的长度
+	GetModerationQueueDepth(ctx context.Context, in *GetModerationQueueDepthRequest, opts ...grpc.CallOption) (*GetModerationQueueDepthReply, error)
+	// 商品列表页批量获取商品的评价统计
+	BulkGetReviewStats(ctx context.Context, in *BulkGetReviewStatsRequest, opts ...grpc.CallOption) (*BulkGetReviewStatsReply, error)
+	// O端获取全平台的评价统计报表
+	GetPlatformReport(ctx context.Context, in *GetPlatformReportRequest, opts ...grpc.CallOption) (*GetPlatformReportReply, error)
+	// O端查看店铺下异常分数高的评价
+	ListAnomalousReviews(ctx context.Context, in *ListAnomalousReviewsRequest, opts ...grpc.CallOption) (*ListAnomalousReviewsReply, error)
+	// B端对比店铺两个时间段的评价数据
+	CompareReviewPeriods(ctx context.Context, in *CompareReviewPeriodsRequest, opts ...grpc.CallOption) (*CompareReviewPeriodsReply, error)
+	// 导出用户的个人数据，HTTP下载接口为 GET /users/{id}/data-export
+	// 只允许用户本人（网关写入的x-user-id）或者带管理密钥的请求访问
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataReply, error)
+	// B端查看店铺最近24小时的热门标签
+	DetectTrendingTags(ctx context.Context, in *DetectTrendingTagsRequest, opts ...grpc.CallOption) (*DetectTrendingTagsReply, error)
+	// C端开始分步骤评价的会话
+	StartReviewSession(ctx context.Context, in *StartReviewSessionRequest, opts ...grpc.CallOption) (*StartReviewSessionReply, error)
+	// C端保存评价会话某一步填写的数据
+	UpdateReviewSession(ctx context.Context, in *UpdateReviewSessionRequest, opts ...grpc.CallOption) (*UpdateReviewSessionReply, error)
+	// C端提交评价会话，三步都完成后创建评价
+	CommitReviewSession(ctx context.Context, in *CommitReviewSessionRequest, opts ...grpc.CallOption) (*CommitReviewSessionReply, error)
+	// O端按店铺和状态查看评价，运维工具使用，需要管理密钥
+	ListReviewByStoreID(ctx context.Context, in *ListReviewByStoreIDRequest, opts ...grpc.CallOption) (*ListReviewByStoreIDReply, error)
 }
 
 type reviewClient struct {
@@ -119,6 +168,150 @@ func (c *reviewClient) ListReviewByUserID(ctx context.Context, in *ListReviewByU
 	return out, nil
 }
 
+func (c *reviewClient) BulkTagReviews(ctx context.Context, in *BulkTagReviewsRequest, opts ...grpc.CallOption) (*BulkTagReviewsReply, error) {
+	out := new(BulkTagReviewsReply)
+	err := c.cc.Invoke(ctx, Review_BulkTagReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) RequestWithdrawal(ctx context.Context, in *RequestWithdrawalRequest, opts ...grpc.CallOption) (*RequestWithdrawalReply, error) {
+	out := new(RequestWithdrawalReply)
+	err := c.cc.Invoke(ctx, Review_RequestWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ApproveWithdrawal(ctx context.Context, in *AuditWithdrawalRequest, opts ...grpc.CallOption) (*AuditWithdrawalReply, error) {
+	out := new(AuditWithdrawalReply)
+	err := c.cc.Invoke(ctx, Review_ApproveWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) DenyWithdrawal(ctx context.Context, in *AuditWithdrawalRequest, opts ...grpc.CallOption) (*AuditWithdrawalReply, error) {
+	out := new(AuditWithdrawalReply)
+	err := c.cc.Invoke(ctx, Review_DenyWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) DequeueModeration(ctx context.Context, in *DequeueModerationRequest, opts ...grpc.CallOption) (*DequeueModerationReply, error) {
+	out := new(DequeueModerationReply)
+	err := c.cc.Invoke(ctx, Review_DequeueModeration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) GetModerationQueueDepth(ctx context.Context, in *GetModerationQueueDepthRequest, opts ...grpc.CallOption) (*GetModerationQueueDepthReply, error) {
+	out := new(GetModerationQueueDepthReply)
+	err := c.cc.Invoke(ctx, Review_GetModerationQueueDepth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) BulkGetReviewStats(ctx context.Context, in *BulkGetReviewStatsRequest, opts ...grpc.CallOption) (*BulkGetReviewStatsReply, error) {
+	out := new(BulkGetReviewStatsReply)
+	err := c.cc.Invoke(ctx, Review_BulkGetReviewStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) GetPlatformReport(ctx context.Context, in *GetPlatformReportRequest, opts ...grpc.CallOption) (*GetPlatformReportReply, error) {
+	out := new(GetPlatformReportReply)
+	err := c.cc.Invoke(ctx, Review_GetPlatformReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListAnomalousReviews(ctx context.Context, in *ListAnomalousReviewsRequest, opts ...grpc.CallOption) (*ListAnomalousReviewsReply, error) {
+	out := new(ListAnomalousReviewsReply)
+	err := c.cc.Invoke(ctx, Review_ListAnomalousReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) CompareReviewPeriods(ctx context.Context, in *CompareReviewPeriodsRequest, opts ...grpc.CallOption) (*CompareReviewPeriodsReply, error) {
+	out := new(CompareReviewPeriodsReply)
+	err := c.cc.Invoke(ctx, Review_CompareReviewPeriods_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataReply, error) {
+	out := new(ExportUserDataReply)
+	err := c.cc.Invoke(ctx, Review_ExportUserData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) DetectTrendingTags(ctx context.Context, in *DetectTrendingTagsRequest, opts ...grpc.CallOption) (*DetectTrendingTagsReply, error) {
+	out := new(DetectTrendingTagsReply)
+	err := c.cc.Invoke(ctx, Review_DetectTrendingTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) StartReviewSession(ctx context.Context, in *StartReviewSessionRequest, opts ...grpc.CallOption) (*StartReviewSessionReply, error) {
+	out := new(StartReviewSessionReply)
+	err := c.cc.Invoke(ctx, Review_StartReviewSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) UpdateReviewSession(ctx context.Context, in *UpdateReviewSessionRequest, opts ...grpc.CallOption) (*UpdateReviewSessionReply, error) {
+	out := new(UpdateReviewSessionReply)
+	err := c.cc.Invoke(ctx, Review_UpdateReviewSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) CommitReviewSession(ctx context.Context, in *CommitReviewSessionRequest, opts ...grpc.CallOption) (*CommitReviewSessionReply, error) {
+	out := new(CommitReviewSessionReply)
+	err := c.cc.Invoke(ctx, Review_CommitReviewSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListReviewByStoreID(ctx context.Context, in *ListReviewByStoreIDRequest, opts ...grpc.CallOption) (*ListReviewByStoreIDReply, error) {
+	out := new(ListReviewByStoreIDReply)
+	err := c.cc.Invoke(ctx, Review_ListReviewByStoreID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReviewServer is the server API for Review service.
 // All implementations must embed UnimplementedReviewServer
 // for forward compatibility
@@ -127,7 +320,7 @@ type ReviewServer interface {
 	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewReply, error)
 	// C端获取评价详情
 	GetReview(context.Context, *GetReviewRequest) (*GetReviewReply, error)
-	// O端审核评价
+	// O端审核评价，需要管理密钥
 	AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error)
 	// B端回复评价
 	ReplyReview(context.Context, *ReplyReviewRequest) (*ReplyReviewReply, error)
@@ -137,6 +330,39 @@ type ReviewServer interface {
 	AuditAppeal(context.Context, *AuditAppealRequest) (*AuditAppealReply, error)
 	// C端查看userID下所有评价
 	ListReviewByUserID(context.Context, *ListReviewByUserIDRequest) (*ListReviewByUserIDReply, error)
+	// O端按条件批量给评价打标签
+	BulkTagReviews(context.Context, *BulkTagReviewsRequest) (*BulkTagReviewsReply, error)
+	// C端申请撤回已审核通过的评价
+	RequestWithdrawal(context.Context, *RequestWithdrawalRequest) (*RequestWithdrawalReply, error)
+	// O端同意撤回评价
+	ApproveWithdrawal(context.Context, *AuditWithdrawalRequest) (*AuditWithdrawalReply, error)
+	// O端拒绝撤回评价
+	DenyWithdrawal(context.Context, *AuditWithdrawalRequest) (*AuditWithdrawalReply, error)
+	// O端从店铺的待审核队列中取出最紧急的一条评价
+	DequeueModeration(context.Context, *DequeueModerationRequest) (*DequeueModerationReply, error)
+	// O端查看店铺待审核队列的长度
+	GetModerationQueueDepth(context.Context, *GetModerationQueueDepthRequest) (*GetModerationQueueDepthReply, error)
+	// 商品列表页批量获取商品的评价统计
+	BulkGetReviewStats(context.Context, *BulkGetReviewStatsRequest) (*BulkGetReviewStatsReply, error)
+	// O端获取全平台的评价统计报表
+	GetPlatformReport(context.Context, *GetPlatformReportRequest) (*GetPlatformReportReply, error)
+	// O端查看店铺下异常分数高的评价
+	ListAnomalousReviews(context.Context, *ListAnomalousReviewsRequest) (*ListAnomalousReviewsReply, error)
+	// B端对比店铺两个时间段的评价数据
+	CompareReviewPeriods(context.Context, *CompareReviewPeriodsRequest) (*CompareReviewPeriodsReply, error)
+	// 导出用户的个人数据，HTTP下载接口为 GET /users/{id}/data-export
+	// 只允许用户本人（网关写入的x-user-id）或者带管理密钥的请求访问
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataReply, error)
+	// B端查看店铺最近24小时的热门标签
+	DetectTrendingTags(context.Context, *DetectTrendingTagsRequest) (*DetectTrendingTagsReply, error)
+	// C端开始分步骤评价的会话
+	StartReviewSession(context.Context, *StartReviewSessionRequest) (*StartReviewSessionReply, error)
+	// C端保存评价会话某一步填写的数据
+	UpdateReviewSession(context.Context, *UpdateReviewSessionRequest) (*UpdateReviewSessionReply, error)
+	// C端提交评价会话，三步都完成后创建评价
+	CommitReviewSession(context.Context, *CommitReviewSessionRequest) (*CommitReviewSessionReply, error)
+	// O端按店铺和状态查看评价，运维工具使用，需要管理密钥
+	ListReviewByStoreID(context.Context, *ListReviewByStoreIDRequest) (*ListReviewByStoreIDReply, error)
 	mustEmbedUnimplementedReviewServer()
 }
 
@@ -165,6 +391,54 @@ func (UnimplementedReviewServer) AuditAppeal(context.Context, *AuditAppealReques
 func (UnimplementedReviewServer) ListReviewByUserID(context.Context, *ListReviewByUserIDRequest) (*ListReviewByUserIDReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReviewByUserID not implemented")
 }
+func (UnimplementedReviewServer) BulkTagReviews(context.Context, *BulkTagReviewsRequest) (*BulkTagReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkTagReviews not implemented")
+}
+func (UnimplementedReviewServer) RequestWithdrawal(context.Context, *RequestWithdrawalRequest) (*RequestWithdrawalReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestWithdrawal not implemented")
+}
+func (UnimplementedReviewServer) ApproveWithdrawal(context.Context, *AuditWithdrawalRequest) (*AuditWithdrawalReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveWithdrawal not implemented")
+}
+func (UnimplementedReviewServer) DenyWithdrawal(context.Context, *AuditWithdrawalRequest) (*AuditWithdrawalReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyWithdrawal not implemented")
+}
+func (UnimplementedReviewServer) DequeueModeration(context.Context, *DequeueModerationRequest) (*DequeueModerationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DequeueModeration not implemented")
+}
+func (UnimplementedReviewServer) GetModerationQueueDepth(context.Context, *GetModerationQueueDepthRequest) (*GetModerationQueueDepthReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationQueueDepth not implemented")
+}
+func (UnimplementedReviewServer) BulkGetReviewStats(context.Context, *BulkGetReviewStatsRequest) (*BulkGetReviewStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkGetReviewStats not implemented")
+}
+func (UnimplementedReviewServer) GetPlatformReport(context.Context, *GetPlatformReportRequest) (*GetPlatformReportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlatformReport not implemented")
+}
+func (UnimplementedReviewServer) ListAnomalousReviews(context.Context, *ListAnomalousReviewsRequest) (*ListAnomalousReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnomalousReviews not implemented")
+}
+func (UnimplementedReviewServer) CompareReviewPeriods(context.Context, *CompareReviewPeriodsRequest) (*CompareReviewPeriodsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareReviewPeriods not implemented")
+}
+func (UnimplementedReviewServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedReviewServer) DetectTrendingTags(context.Context, *DetectTrendingTagsRequest) (*DetectTrendingTagsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectTrendingTags not implemented")
+}
+func (UnimplementedReviewServer) StartReviewSession(context.Context, *StartReviewSessionRequest) (*StartReviewSessionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartReviewSession not implemented")
+}
+func (UnimplementedReviewServer) UpdateReviewSession(context.Context, *UpdateReviewSessionRequest) (*UpdateReviewSessionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReviewSession not implemented")
+}
+func (UnimplementedReviewServer) CommitReviewSession(context.Context, *CommitReviewSessionRequest) (*CommitReviewSessionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReviewSession not implemented")
+}
+func (UnimplementedReviewServer) ListReviewByStoreID(context.Context, *ListReviewByStoreIDRequest) (*ListReviewByStoreIDReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewByStoreID not implemented")
+}
 func (UnimplementedReviewServer) mustEmbedUnimplementedReviewServer() {}
 
 // UnsafeReviewServer may be embedded to opt out of forward compatibility for this service.
@@ -304,6 +578,294 @@ func _Review_ListReviewByUserID_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Review_BulkTagReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkTagReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).BulkTagReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_BulkTagReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).BulkTagReviews(ctx, req.(*BulkTagReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_RequestWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).RequestWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_RequestWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).RequestWithdrawal(ctx, req.(*RequestWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ApproveWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ApproveWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ApproveWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ApproveWithdrawal(ctx, req.(*AuditWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_DenyWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).DenyWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_DenyWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).DenyWithdrawal(ctx, req.(*AuditWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_DequeueModeration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DequeueModerationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).DequeueModeration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_DequeueModeration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).DequeueModeration(ctx, req.(*DequeueModerationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_GetModerationQueueDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationQueueDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetModerationQueueDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_GetModerationQueueDepth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetModerationQueueDepth(ctx, req.(*GetModerationQueueDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_BulkGetReviewStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkGetReviewStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).BulkGetReviewStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_BulkGetReviewStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).BulkGetReviewStats(ctx, req.(*BulkGetReviewStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_GetPlatformReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlatformReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetPlatformReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_GetPlatformReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetPlatformReport(ctx, req.(*GetPlatformReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ListAnomalousReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnomalousReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListAnomalousReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListAnomalousReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListAnomalousReviews(ctx, req.(*ListAnomalousReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_CompareReviewPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareReviewPeriodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).CompareReviewPeriods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_CompareReviewPeriods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).CompareReviewPeriods(ctx, req.(*CompareReviewPeriodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_DetectTrendingTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectTrendingTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).DetectTrendingTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_DetectTrendingTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).DetectTrendingTags(ctx, req.(*DetectTrendingTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_StartReviewSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartReviewSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).StartReviewSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_StartReviewSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).StartReviewSession(ctx, req.(*StartReviewSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_UpdateReviewSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).UpdateReviewSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_UpdateReviewSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).UpdateReviewSession(ctx, req.(*UpdateReviewSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_CommitReviewSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReviewSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).CommitReviewSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_CommitReviewSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).CommitReviewSession(ctx, req.(*CommitReviewSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ListReviewByStoreID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewByStoreIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListReviewByStoreID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListReviewByStoreID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListReviewByStoreID(ctx, req.(*ListReviewByStoreIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Review_ServiceDesc is the grpc.ServiceDesc for Review service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -339,6 +901,70 @@ var Review_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReviewByUserID",
 			Handler:    _Review_ListReviewByUserID_Handler,
 		},
+		{
+			MethodName: "BulkTagReviews",
+			Handler:    _Review_BulkTagReviews_Handler,
+		},
+		{
+			MethodName: "RequestWithdrawal",
+			Handler:    _Review_RequestWithdrawal_Handler,
+		},
+		{
+			MethodName: "ApproveWithdrawal",
+			Handler:    _Review_ApproveWithdrawal_Handler,
+		},
+		{
+			MethodName: "DenyWithdrawal",
+			Handler:    _Review_DenyWithdrawal_Handler,
+		},
+		{
+			MethodName: "DequeueModeration",
+			Handler:    _Review_DequeueModeration_Handler,
+		},
+		{
+			MethodName: "GetModerationQueueDepth",
+			Handler:    _Review_GetModerationQueueDepth_Handler,
+		},
+		{
+			MethodName: "BulkGetReviewStats",
+			Handler:    _Review_BulkGetReviewStats_Handler,
+		},
+		{
+			MethodName: "GetPlatformReport",
+			Handler:    _Review_GetPlatformReport_Handler,
+		},
+		{
+			MethodName: "ListAnomalousReviews",
+			Handler:    _Review_ListAnomalousReviews_Handler,
+		},
+		{
+			MethodName: "CompareReviewPeriods",
+			Handler:    _Review_CompareReviewPeriods_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _Review_ExportUserData_Handler,
+		},
+		{
+			MethodName: "DetectTrendingTags",
+			Handler:    _Review_DetectTrendingTags_Handler,
+		},
+		{
+			MethodName: "StartReviewSession",
+			Handler:    _Review_StartReviewSession_Handler,
+		},
+		{
+			MethodName: "UpdateReviewSession",
+			Handler:    _Review_UpdateReviewSession_Handler,
+		},
+		{
+			MethodName: "CommitReviewSession",
+			Handler:    _Review_CommitReviewSession_Handler,
+		},
+		{
+			MethodName: "ListReviewByStoreID",
+			Handler:    _Review_ListReviewByStoreID_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "review/v1/review.proto",
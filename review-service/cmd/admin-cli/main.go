@@ -0,0 +1,275 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	v1 "review-service/api/review/v1"
+	"review-service/pkg/client"
+
+	"github.com/spf13/cobra"
+)
+
+// 评价服务运维命令行工具
+//
+//	admin-cli --addr 127.0.0.1:9000 review get 123
+//	admin-cli review approve 123 --op-user ops
+//	admin-cli review reject 123 --reason 广告 --op-user ops
+//	admin-cli review list --store 1 --status pending --page 1 --size 20
+//	admin-cli review export --store 1 --output reviews.csv
+//
+// approve/reject/list/export是管理接口，服务端需要设置ADMIN_KEY环境变量，调用时通过--admin-key传入相同的密钥
+
+const (
+	// 评价状态，与review_info.status一致
+	statusApproved = 20
+	statusRejected = 30
+
+	exportPageSize = 100
+	requestTimeout = 10 * time.Second
+)
+
+// statuses --status可选的取值
+var statuses = map[string]int32{
+	"all":                0,
+	"pending":            10,
+	"approved":           statusApproved,
+	"rejected":           statusRejected,
+	"hidden":             40,
+	"withdrawal-pending": 50,
+	"withdrawn":          60,
+}
+
+var cfg client.Config
+
+// dial 连接评价服务，测试时替换为连接假服务
+var dial = client.NewReviewClient
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:          "admin-cli",
+		Short:        "评价服务运维工具",
+		SilenceUsage: true,
+	}
+	root.PersistentFlags().StringVar(&cfg.Addr, "addr", "127.0.0.1:9000", "评价服务gRPC地址")
+	root.PersistentFlags().StringVar(&cfg.AdminKey, "admin-key", "", "管理接口密钥")
+	root.PersistentFlags().StringVar(&cfg.TLSCert, "tls-cert", "", "服务端证书路径，为空时使用明文连接")
+
+	review := &cobra.Command{Use: "review", Short: "评价管理"}
+	review.AddCommand(newGetCmd(), newApproveCmd(), newRejectCmd(), newListCmd(), newExportCmd())
+	root.AddCommand(review)
+	return root
+}
+
+// withClient 连接评价服务并执行fn
+func withClient(fn func(ctx context.Context, c v1.ReviewClient) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	c, closeFn, err := dial(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+	return fn(ctx, c)
+}
+
+func parseStatus(s string) (int32, error) {
+	status, ok := statuses[s]
+	if !ok {
+		return 0, fmt.Errorf("invalid status %q", s)
+	}
+	return status, nil
+}
+
+func parseID(s string) (int64, error) {
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid id %q", s)
+	}
+	return id, nil
+}
+
+func newGetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <id>",
+		Short: "查看评价详情",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+			return withClient(func(ctx context.Context, c v1.ReviewClient) error {
+				reply, err := c.GetReview(ctx, &v1.GetReviewRequest{ReviewID: id})
+				if err != nil {
+					return err
+				}
+				printReview(cmd, reply.GetData())
+				return nil
+			})
+		},
+	}
+}
+
+func newApproveCmd() *cobra.Command {
+	var opUser string
+	cmd := &cobra.Command{
+		Use:   "approve <id>",
+		Short: "审核通过评价",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return audit(cmd, args[0], statusApproved, opUser, "审核通过")
+		},
+	}
+	cmd.Flags().StringVar(&opUser, "op-user", "admin-cli", "运营者标识")
+	return cmd
+}
+
+func newRejectCmd() *cobra.Command {
+	var opUser, reason string
+	cmd := &cobra.Command{
+		Use:   "reject <id>",
+		Short: "审核拒绝评价",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return audit(cmd, args[0], statusRejected, opUser, reason)
+		},
+	}
+	cmd.Flags().StringVar(&opUser, "op-user", "admin-cli", "运营者标识")
+	cmd.Flags().StringVar(&reason, "reason", "", "拒绝原因")
+	cmd.MarkFlagRequired("reason")
+	return cmd
+}
+
+func audit(cmd *cobra.Command, arg string, status int32, opUser, reason string) error {
+	id, err := parseID(arg)
+	if err != nil {
+		return err
+	}
+	return withClient(func(ctx context.Context, c v1.ReviewClient) error {
+		reply, err := c.AuditReview(ctx, &v1.AuditReviewRequest{
+			ReviewID: id,
+			Status:   status,
+			OpUser:   opUser,
+			OpReason: reason,
+		})
+		if err != nil {
+			return err
+		}
+		cmd.Printf("review %d status %d\n", reply.GetReviewID(), reply.GetStatus())
+		return nil
+	})
+}
+
+func newListCmd() *cobra.Command {
+	var (
+		storeID    int64
+		statusName string
+		page, size int32
+	)
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "查看店铺的评价列表",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			status, err := parseStatus(statusName)
+			if err != nil {
+				return err
+			}
+			return withClient(func(ctx context.Context, c v1.ReviewClient) error {
+				reply, err := c.ListReviewByStoreID(ctx, &v1.ListReviewByStoreIDRequest{StoreID: storeID, Status: status, Page: page, Size: size})
+				if err != nil {
+					return err
+				}
+				for _, review := range reply.GetList() {
+					printReview(cmd, review)
+				}
+				return nil
+			})
+		},
+	}
+	cmd.Flags().Int64Var(&storeID, "store", 0, "店铺id")
+	cmd.Flags().StringVar(&statusName, "status", "all", "评价状态：all/pending/approved/rejected/hidden/withdrawal-pending/withdrawn")
+	cmd.Flags().Int32Var(&page, "page", 1, "页码")
+	cmd.Flags().Int32Var(&size, "size", 20, "每页数量")
+	cmd.MarkFlagRequired("store")
+	return cmd
+}
+
+func newExportCmd() *cobra.Command {
+	var (
+		storeID    int64
+		statusName string
+		output     string
+	)
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "导出店铺的评价到CSV",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			status, err := parseStatus(statusName)
+			if err != nil {
+				return err
+			}
+			f, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w := csv.NewWriter(f)
+			w.Write([]string{"review_id", "user_id", "order_id", "score", "service_score", "express_score", "status", "content"})
+			var total int
+			err = withClient(func(ctx context.Context, c v1.ReviewClient) error {
+				for page := int32(1); ; page++ {
+					reply, err := c.ListReviewByStoreID(ctx, &v1.ListReviewByStoreIDRequest{StoreID: storeID, Status: status, Page: page, Size: exportPageSize})
+					if err != nil {
+						return err
+					}
+					for _, r := range reply.GetList() {
+						w.Write([]string{
+							strconv.FormatInt(r.GetReviewID(), 10),
+							strconv.FormatInt(r.GetUserID(), 10),
+							strconv.FormatInt(r.GetOrderID(), 10),
+							strconv.Itoa(int(r.GetScore())),
+							strconv.Itoa(int(r.GetServiceScore())),
+							strconv.Itoa(int(r.GetExpressScore())),
+							strconv.Itoa(int(r.GetStatus())),
+							r.GetContent(),
+						})
+					}
+					total += len(reply.GetList())
+					if len(reply.GetList()) < exportPageSize {
+						return nil
+					}
+				}
+			})
+			w.Flush()
+			if err == nil {
+				err = w.Error()
+			}
+			if err != nil {
+				return err
+			}
+			cmd.Printf("exported %d reviews to %s\n", total, output)
+			return nil
+		},
+	}
+	cmd.Flags().Int64Var(&storeID, "store", 0, "店铺id")
+	cmd.Flags().StringVar(&statusName, "status", "all", "评价状态：all/pending/approved/rejected/hidden/withdrawal-pending/withdrawn")
+	cmd.Flags().StringVar(&output, "output", "reviews.csv", "导出文件路径")
+	cmd.MarkFlagRequired("store")
+	return cmd
+}
+
+func printReview(cmd *cobra.Command, r *v1.ReviewInfo) {
+	cmd.Printf("reviewID:%d userID:%d orderID:%d score:%d status:%d content:%s\n",
+		r.GetReviewID(), r.GetUserID(), r.GetOrderID(), r.GetScore(), r.GetStatus(), r.GetContent())
+}
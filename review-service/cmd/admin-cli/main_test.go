@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	v1 "review-service/api/review/v1"
+	"review-service/pkg/client"
+	"review-service/pkg/client/testutil"
+)
+
+// useFakeServer 让命令连接到假评价服务
+func useFakeServer(t *testing.T, srv *testutil.FakeReviewServer) {
+	t.Helper()
+	c := srv.Start(t)
+	old := dial
+	dial = func(context.Context, client.Config) (v1.ReviewClient, func(), error) {
+		return c, func() {}, nil
+	}
+	t.Cleanup(func() { dial = old })
+}
+
+func execute(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	root := newRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetErr(&out)
+	root.SetArgs(args)
+	err := root.Execute()
+	return out.String(), err
+}
+
+func TestCLICompiles(t *testing.T) {
+	root := newRootCmd()
+	for _, name := range []string{"get", "approve", "reject", "list", "export"} {
+		if _, _, err := root.Find([]string{"review", name}); err != nil {
+			t.Fatalf("subcommand review %s not found, err:%v", name, err)
+		}
+	}
+}
+
+func TestReviewGet(t *testing.T) {
+	srv := testutil.NewFakeServer().
+		WithGetReviewReturning(&v1.GetReviewReply{Data: &v1.ReviewInfo{ReviewID: 123, UserID: 1, Content: "物流很快，包装完好"}}).
+		Build()
+	useFakeServer(t, srv)
+
+	out, err := execute(t, "review", "get", "123")
+	if err != nil {
+		t.Fatalf("review get fail, err:%v", err)
+	}
+	if !strings.Contains(out, "reviewID:123") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	if calls := srv.GetReviewCalls(); len(calls) != 1 || calls[0].GetReviewID() != 123 {
+		t.Fatalf("unexpected GetReview calls: %v", calls)
+	}
+}
+
+func TestReviewListByStoreAndStatus(t *testing.T) {
+	srv := testutil.NewFakeServer().
+		WithListReviewByStoreIDReturning(&v1.ListReviewByStoreIDReply{List: []*v1.ReviewInfo{{ReviewID: 1}, {ReviewID: 2}}}).
+		Build()
+	useFakeServer(t, srv)
+
+	out, err := execute(t, "review", "list", "--store", "7", "--status", "pending")
+	if err != nil {
+		t.Fatalf("review list fail, err:%v", err)
+	}
+	if strings.Count(out, "reviewID:") != 2 {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	calls := srv.ListReviewByStoreIDCalls()
+	if len(calls) != 1 || calls[0].GetStoreID() != 7 || calls[0].GetStatus() != 10 {
+		t.Fatalf("unexpected ListReviewByStoreID calls: %v", calls)
+	}
+
+	if _, err := execute(t, "review", "list", "--store", "7", "--status", "unknown"); err == nil {
+		t.Fatal("expected error for unknown status")
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// protoc-gen-go生成的描述符去掉了SourceCodeInfo，编译进二进制的FileDescriptor里没有注释，
+// 所以注释直接从.proto源文件里扫描，按全限定名(package.Service.Method、package.Message.field)索引
+
+var (
+	declRe  = regexp.MustCompile(`^(service|message|enum|oneof)\s+(\w+)`)
+	rpcRe   = regexp.MustCompile(`^rpc\s+(\w+)\s*\(`)
+	fieldRe = regexp.MustCompile(`^(?:(?:repeated|optional)\s+)?(?:map\s*<[^>]*>|[\w.]+)\s+(\w+)\s*=\s*\d+`)
+	valueRe = regexp.MustCompile(`^(\w+)\s*=\s*-?\d+`)
+	strRe   = regexp.MustCompile(`"(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'`)
+)
+
+type scope struct {
+	kind string
+	// name 为空表示option等不参与命名的代码块
+	name string
+}
+
+// readComments 扫描proto源文件，返回全限定名到前导注释（没有前导注释时取行尾注释）的映射
+func readComments(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	comments := make(map[string]string)
+	var (
+		pkg     string
+		stack   []scope
+		pending []string
+	)
+	fullName := func(name string) string {
+		parts := []string{}
+		if pkg != "" {
+			parts = append(parts, pkg)
+		}
+		for _, s := range stack {
+			// oneof里的字段属于外层message
+			if s.name != "" && s.kind != "oneof" {
+				parts = append(parts, s.name)
+			}
+		}
+		return strings.Join(append(parts, name), ".")
+	}
+	record := func(name, trailing string) {
+		switch {
+		case len(pending) > 0:
+			comments[fullName(name)] = strings.Join(pending, "\n")
+		case trailing != "":
+			comments[fullName(name)] = trailing
+		}
+	}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "//") {
+			pending = append(pending, strings.TrimSpace(strings.TrimPrefix(line, "//")))
+			continue
+		}
+		if line == "" {
+			pending = nil
+			continue
+		}
+		// 去掉字符串字面量后再找行尾注释和花括号，避免HTTP路径里的{reviewID}、响应示例里的JSON被当成代码块
+		code := strRe.ReplaceAllString(line, `""`)
+		var trailing string
+		if i := strings.Index(code, "//"); i >= 0 {
+			trailing = strings.TrimSpace(code[i+2:])
+			code = code[:i]
+		}
+
+		named := false
+		var top scope
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+		switch {
+		case strings.HasPrefix(code, "package "):
+			pkg = strings.TrimSuffix(strings.TrimSpace(strings.TrimPrefix(code, "package ")), ";")
+		case declRe.MatchString(code):
+			m := declRe.FindStringSubmatch(code)
+			record(m[2], trailing)
+			if strings.Contains(code, "{") {
+				stack = append(stack, scope{kind: m[1], name: m[2]})
+				named = true
+			}
+		case top.kind == "service" && rpcRe.MatchString(code):
+			record(rpcRe.FindStringSubmatch(code)[1], trailing)
+		case (top.kind == "message" || top.kind == "oneof") && fieldRe.MatchString(code):
+			record(fieldRe.FindStringSubmatch(code)[1], trailing)
+		case top.kind == "enum" && valueRe.MatchString(code):
+			record(valueRe.FindStringSubmatch(code)[1], trailing)
+		}
+		pending = nil
+
+		depth := strings.Count(code, "{") - strings.Count(code, "}")
+		if named {
+			depth--
+		}
+		for ; depth > 0; depth-- {
+			stack = append(stack, scope{})
+		}
+		for ; depth < 0 && len(stack) > 0; depth++ {
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return comments, scanner.Err()
+}
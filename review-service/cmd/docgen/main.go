@@ -0,0 +1,248 @@
+// docgen 根据评价服务的proto描述符生成Markdown格式的接口文档
+// 接口、消息和字段的结构取自编译进二进制的FileDescriptor，说明取自proto源文件里的注释
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "review-service/api/review/v1"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+//go:generate go run . -proto ../../api/review/v1/review.proto -out ../../docs/api.md
+
+var (
+	protoPath string
+	outPath   string
+)
+
+func init() {
+	flag.StringVar(&protoPath, "proto", "api/review/v1/review.proto", "proto source file, used for comments")
+	flag.StringVar(&outPath, "out", "docs/api.md", "output markdown file")
+}
+
+func main() {
+	flag.Parse()
+	comments, err := readComments(protoPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "docgen: read comments fail, err:%v\n", err)
+		os.Exit(1)
+	}
+	fd := protodesc.ToFileDescriptorProto(v1.File_api_review_v1_review_proto)
+	doc := render(fd, comments)
+	if err := os.MkdirAll(filepath.Dir(outPath), 0o755); err != nil {
+		fmt.Fprintf(os.Stderr, "docgen: mkdir fail, err:%v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(outPath, doc, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "docgen: write %s fail, err:%v\n", outPath, err)
+		os.Exit(1)
+	}
+}
+
+// generator 渲染一个proto文件的文档
+type generator struct {
+	buf      bytes.Buffer
+	pkg      string
+	comments map[string]string
+	// messages 全限定名到消息描述的映射，用于识别map字段
+	messages map[string]*descriptorpb.DescriptorProto
+}
+
+func render(fd *descriptorpb.FileDescriptorProto, comments map[string]string) []byte {
+	g := &generator{
+		pkg:      fd.GetPackage(),
+		comments: comments,
+		messages: make(map[string]*descriptorpb.DescriptorProto),
+	}
+	for _, m := range fd.GetMessageType() {
+		g.indexMessage(g.pkg, m)
+	}
+
+	g.printf("# %s API\n\n", g.pkg)
+	g.printf("> 本文档由 `cmd/docgen` 根据 `%s` 生成，请勿手动修改，修改proto后执行 `go generate ./cmd/docgen/...` 更新\n\n", fd.GetName())
+	for _, svc := range fd.GetService() {
+		g.service(svc)
+	}
+	if len(fd.GetMessageType()) > 0 {
+		g.printf("## 消息\n\n")
+		for _, m := range fd.GetMessageType() {
+			g.message(g.pkg, "", m)
+		}
+	}
+	if len(fd.GetEnumType()) > 0 {
+		g.printf("## 枚举\n\n")
+		for _, e := range fd.GetEnumType() {
+			g.enum(g.pkg, "", e)
+		}
+	}
+	return append(bytes.TrimRight(g.buf.Bytes(), "\n"), '\n')
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) indexMessage(prefix string, m *descriptorpb.DescriptorProto) {
+	name := prefix + "." + m.GetName()
+	g.messages[name] = m
+	for _, nested := range m.GetNestedType() {
+		g.indexMessage(name, nested)
+	}
+}
+
+func (g *generator) service(svc *descriptorpb.ServiceDescriptorProto) {
+	name := g.pkg + "." + svc.GetName()
+	g.printf("## 服务 %s\n\n", svc.GetName())
+	if c := g.comments[name]; c != "" {
+		g.printf("%s\n\n", c)
+	}
+
+	g.printf("| 方法 | HTTP | 说明 |\n")
+	g.printf("| --- | --- | --- |\n")
+	for _, m := range svc.GetMethod() {
+		g.printf("| [%s](#%s) | %s | %s |\n", m.GetName(), anchor(m.GetName()), httpRoute(m), inline(g.comments[name+"."+m.GetName()]))
+	}
+	g.printf("\n")
+
+	for _, m := range svc.GetMethod() {
+		g.printf("### %s\n\n", m.GetName())
+		if c := g.comments[name+"."+m.GetName()]; c != "" {
+			g.printf("%s\n\n", c)
+		}
+		g.printf("- gRPC: `/%s/%s`\n", name, m.GetName())
+		if route := httpRoute(m); route != "-" {
+			g.printf("- HTTP: %s\n", route)
+		}
+		g.printf("- 请求: %s\n", g.typeLink(m.GetInputType()))
+		g.printf("- 响应: %s\n\n", g.typeLink(m.GetOutputType()))
+	}
+}
+
+func (g *generator) message(prefix, parent string, m *descriptorpb.DescriptorProto) {
+	// map字段对应的Entry消息是编译器生成的，不单独列出
+	if m.GetOptions().GetMapEntry() {
+		return
+	}
+	fullName := prefix + "." + m.GetName()
+	title := m.GetName()
+	if parent != "" {
+		title = parent + "." + m.GetName()
+	}
+	g.printf("### %s\n\n", title)
+	if c := g.comments[fullName]; c != "" {
+		g.printf("%s\n\n", c)
+	}
+	if len(m.GetField()) > 0 {
+		g.printf("| 字段 | 类型 | 编号 | 说明 |\n")
+		g.printf("| --- | --- | --- | --- |\n")
+		for _, f := range m.GetField() {
+			g.printf("| %s | %s | %d | %s |\n", f.GetName(), g.fieldType(f), f.GetNumber(), inline(g.comments[fullName+"."+f.GetName()]))
+		}
+		g.printf("\n")
+	}
+	for _, nested := range m.GetNestedType() {
+		g.message(fullName, title, nested)
+	}
+	for _, e := range m.GetEnumType() {
+		g.enum(fullName, title, e)
+	}
+}
+
+func (g *generator) enum(prefix, parent string, e *descriptorpb.EnumDescriptorProto) {
+	fullName := prefix + "." + e.GetName()
+	title := e.GetName()
+	if parent != "" {
+		title = parent + "." + e.GetName()
+	}
+	g.printf("### %s\n\n", title)
+	if c := g.comments[fullName]; c != "" {
+		g.printf("%s\n\n", c)
+	}
+	g.printf("| 取值 | 编号 | 说明 |\n")
+	g.printf("| --- | --- | --- |\n")
+	for _, v := range e.GetValue() {
+		g.printf("| %s | %d | %s |\n", v.GetName(), v.GetNumber(), inline(g.comments[fullName+"."+v.GetName()]))
+	}
+	g.printf("\n")
+}
+
+// fieldType 字段类型，消息和枚举类型链接到对应的章节
+func (g *generator) fieldType(f *descriptorpb.FieldDescriptorProto) string {
+	if entry, ok := g.messages[strings.TrimPrefix(f.GetTypeName(), ".")]; ok && entry.GetOptions().GetMapEntry() {
+		key, value := entry.GetField()[0], entry.GetField()[1]
+		// 尖括号转义，避免在表格里被当成HTML标签
+		return fmt.Sprintf("map&lt;%s, %s&gt;", g.scalarOrLink(key), g.scalarOrLink(value))
+	}
+	typ := g.scalarOrLink(f)
+	switch {
+	case f.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED:
+		return "repeated " + typ
+	case f.GetProto3Optional():
+		return "optional " + typ
+	}
+	return typ
+}
+
+func (g *generator) scalarOrLink(f *descriptorpb.FieldDescriptorProto) string {
+	switch f.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		return g.typeLink(f.GetTypeName())
+	}
+	return strings.ToLower(strings.TrimPrefix(f.GetType().String(), "TYPE_"))
+}
+
+// typeLink 本文件内的类型链接到对应章节，其他文件的类型只显示全限定名
+func (g *generator) typeLink(typeName string) string {
+	name := strings.TrimPrefix(typeName, ".")
+	local := strings.TrimPrefix(name, g.pkg+".")
+	if local == name {
+		return "`" + name + "`"
+	}
+	return fmt.Sprintf("[%s](#%s)", local, anchor(local))
+}
+
+// httpRoute google.api.http注解定义的HTTP路由，没有注解时返回"-"
+func httpRoute(m *descriptorpb.MethodDescriptorProto) string {
+	rule, ok := proto.GetExtension(m.GetOptions(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return "-"
+	}
+	var method, path string
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		method, path = "GET", p.Get
+	case *annotations.HttpRule_Post:
+		method, path = "POST", p.Post
+	case *annotations.HttpRule_Put:
+		method, path = "PUT", p.Put
+	case *annotations.HttpRule_Delete:
+		method, path = "DELETE", p.Delete
+	case *annotations.HttpRule_Patch:
+		method, path = "PATCH", p.Patch
+	case *annotations.HttpRule_Custom:
+		method, path = p.Custom.GetKind(), p.Custom.GetPath()
+	default:
+		return "-"
+	}
+	return fmt.Sprintf("`%s %s`", method, path)
+}
+
+// anchor GitHub为标题生成的锚点：转小写并去掉标点
+func anchor(title string) string {
+	return strings.ToLower(strings.ReplaceAll(title, ".", ""))
+}
+
+// inline 多行注释合并成一行，转义表格分隔符
+func inline(comment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(comment, "\n", " "), "|", `\|`)
+}
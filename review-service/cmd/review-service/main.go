@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
 	"os"
 
+	"review-service/internal/biz"
 	"review-service/internal/conf"
-	"review-service/pkg/snowflake"
+	"review-service/internal/data"
+	confcheck "review-service/pkg/conf"
+	"review-service/pkg/metrics"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -35,7 +40,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.Server, rollup *biz.RollupJob, sentiment *biz.SentimentAlertJob, detector *biz.ReviewAnomalyDetector, probe *data.ReplicationLagProbe, reloader *configReloader) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -45,6 +50,11 @@ func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.S
 		kratos.Server(
 			gs,
 			hs,
+			rollup,    // 评价创建数小时汇总
+			sentiment, // 商品评分突变告警
+			detector,  // 评价异常检测定时重新训练
+			probe,     // 从库复制延迟探测
+			reloader,  // SIGHUP时重新加载业务配置
 		),
 		kratos.Registrar(r), // 服务注册
 	)
@@ -76,26 +86,29 @@ func main() {
 	if err := c.Scan(&bc); err != nil {
 		panic(err)
 	}
+	// 校验配置，打印所有错误之后再退出
+	if errs := confcheck.ValidateConfig(&bc); len(errs) > 0 {
+		fmt.Fprint(os.Stderr, confcheck.FormatErrors(errs))
+		panic(fmt.Sprintf("invalid config: %d errors", len(errs)))
+	}
 	// 解析registry.yaml中的配置
 	var rc conf.Registry
 	if err := c.Scan(&rc); err != nil {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, &rc, bc.Data, logger)
+	// 按依赖顺序检查数据库和Redis、初始化snowflake、创建服务
+	boot := newBootstrap(&bc, &rc, logger)
+	defer boot.close()
+	app, err := boot.run()
 	if err != nil {
 		panic(err)
 	}
-	defer cleanup()
 
-	// 初始化snowflake
-	// bc.Snowflake.StartTime
-	if err := snowflake.Init(
-		bc.Snowflake.StartTime,
-		bc.Snowflake.MachineId,
-	); err != nil {
-		panic(err)
-	}
+	// 管理后台指标每分钟清零
+	metricsCtx, cancelMetrics := context.WithCancel(context.Background())
+	defer cancelMetrics()
+	metrics.Default().Start(metricsCtx, metrics.DefaultResetInterval)
 
 	// start and wait for stop signal
 	if err := app.Run(); err != nil {
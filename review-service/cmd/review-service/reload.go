@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	confcheck "review-service/pkg/conf"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// configReloader 收到SIGHUP时重新读取配置文件并替换业务配置，实现了transport.Server，随kratos.App启停
+// 只有business部分支持热更新，其他配置修改后仍需要重启
+type configReloader struct {
+	review *biz.ReviewUsecase
+	log    *log.Helper
+	sig    chan os.Signal
+	stop   chan struct{}
+}
+
+// newConfigReloader 创建时就开始接收SIGHUP，Start之前收到的信号不会按默认行为结束进程
+func newConfigReloader(review *biz.ReviewUsecase, logger log.Logger) *configReloader {
+	r := &configReloader{
+		review: review,
+		log:    log.NewHelper(logger),
+		sig:    make(chan os.Signal, 1),
+		stop:   make(chan struct{}),
+	}
+	signal.Notify(r.sig, syscall.SIGHUP)
+	return r
+}
+
+func (r *configReloader) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.stop:
+			return nil
+		case <-r.sig:
+			if err := r.reload(); err != nil {
+				r.log.Errorf("reload config fail, keep the current config, err:%v", err)
+				continue
+			}
+			r.log.Info("config reloaded")
+		}
+	}
+}
+
+func (r *configReloader) Stop(context.Context) error {
+	signal.Stop(r.sig)
+	close(r.stop)
+	return nil
+}
+
+// reload 重新读取并校验配置文件，校验不通过时不替换
+func (r *configReloader) reload() error {
+	c := config.New(config.WithSource(file.NewSource(flagconf)))
+	defer c.Close()
+	if err := c.Load(); err != nil {
+		return err
+	}
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		return err
+	}
+	if errs := confcheck.ValidateConfig(&bc); len(errs) > 0 {
+		return fmt.Errorf("invalid config: %d errors\n%s", len(errs), confcheck.FormatErrors(errs))
+	}
+	r.review.Reload(bc.Business)
+	return nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const testConfig = `
+server:
+  http:
+    addr: 0.0.0.0:8000
+  grpc:
+    addr: 0.0.0.0:9000
+data:
+  database:
+    driver: sqlite
+    source: "file::memory:"
+snowflake:
+  start_time: "2026-01-01"
+  machine_id: 1
+business:
+  min_review_length: %d
+`
+
+// orderLookupFailRepo 查询订单时直接返回错误，评价内容校验通过后CreateReview在这一步结束
+type orderLookupFailRepo struct {
+	biz.ReviewRepo
+}
+
+func (orderLookupFailRepo) GetReviewByOrderID(context.Context, int64) ([]*model.ReviewInfo, error) {
+	return nil, errors.New("order lookup disabled in test")
+}
+
+func writeTestConfig(t *testing.T, path string, minReviewLength int) {
+	t.Helper()
+	content := fmt.Sprintf(testConfig, minReviewLength)
+	// 先写临时文件再改名，重新加载时不会读到写了一半的文件
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(content), 0o644); err != nil {
+		t.Fatalf("write config fail, err:%v", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatalf("rename config fail, err:%v", err)
+	}
+}
+
+func TestConfigReloaderSIGHUP(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeTestConfig(t, path, 0)
+	old := flagconf
+	flagconf = path
+	t.Cleanup(func() { flagconf = old })
+
+	uc := biz.NewReviewUsecase(orderLookupFailRepo{}, nil, nil, &conf.Business{}, log.DefaultLogger)
+	reloader := newConfigReloader(uc, log.DefaultLogger)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- reloader.Start(ctx) }()
+	defer func() {
+		reloader.Stop(context.Background())
+		<-done
+	}()
+
+	createShortReview := func() error {
+		_, err := uc.CreateReview(context.Background(), &model.ReviewInfo{OrderID: 1, Content: "太短"})
+		return err
+	}
+	if err := createShortReview(); v1.IsContentTooShort(err) {
+		t.Fatalf("CreateReview before reload err = %v, want content length not checked", err)
+	}
+
+	writeTestConfig(t, path, 5)
+	if err := syscall.Kill(os.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatalf("send SIGHUP fail, err:%v", err)
+	}
+	deadline := time.Now().Add(500 * time.Millisecond)
+	for {
+		err := createShortReview()
+		if v1.IsContentTooShort(err) {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("CreateReview 500ms after SIGHUP err = %v, want CONTENT_TOO_SHORT", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"review-service/internal/conf"
+	"review-service/internal/data"
+	"review-service/pkg/snowflake"
+	"review-service/pkg/snowflake/epoch"
+	"review-service/pkg/startup"
+
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// startupTimeout 启动阶段（不含运行服务）的总超时时间
+const startupTimeout = 30 * time.Second
+
+// bootstrap 按 DB → Redis → Snowflake → 服务 的顺序完成启动准备
+// 依赖的组件连不上时后面的阶段直接跳过，错误里列出失败和跳过的阶段，不会带着不可用的依赖启动服务
+type bootstrap struct {
+	bc     *conf.Bootstrap
+	rc     *conf.Registry
+	logger log.Logger
+
+	app      *kratos.App
+	cleanups []func()
+}
+
+func newBootstrap(bc *conf.Bootstrap, rc *conf.Registry, logger log.Logger) *bootstrap {
+	return &bootstrap{bc: bc, rc: rc, logger: logger}
+}
+
+func (b *bootstrap) sequencer() *startup.Sequencer {
+	return startup.NewSequencer(
+		startup.Stage{Name: "db", Run: b.checkDB},
+		startup.Stage{Name: "redis", DependsOn: []string{"db"}, Run: b.checkRedis},
+		startup.Stage{Name: "snowflake", DependsOn: []string{"redis"}, Run: b.initSnowflake},
+		startup.Stage{Name: "servers", DependsOn: []string{"snowflake"}, Run: b.initServers},
+	)
+}
+
+// run 执行所有启动阶段，成功后返回可以运行的App，失败时已经完成的阶段由close清理
+func (b *bootstrap) run() (*kratos.App, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), startupTimeout)
+	defer cancel()
+	if err := b.sequencer().Run(ctx); err != nil {
+		return nil, err
+	}
+	return b.app, nil
+}
+
+// close 按完成顺序的逆序清理
+func (b *bootstrap) close() {
+	for i := len(b.cleanups) - 1; i >= 0; i-- {
+		b.cleanups[i]()
+	}
+}
+
+func (b *bootstrap) checkDB(ctx context.Context) error {
+	return data.PingDB(ctx, b.bc.Data)
+}
+
+func (b *bootstrap) checkRedis(ctx context.Context) error {
+	return data.PingRedis(ctx, b.bc.Data)
+}
+
+func (b *bootstrap) initSnowflake(context.Context) error {
+	if err := initDefaultSnowflake(b.bc.Snowflake); err != nil {
+		return err
+	}
+	// 多节点部署时监控machineID冲突
+	if b.bc.Snowflake.MonitorInterval != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		rdb := data.NewRedisClient(b.bc.Data)
+		snowflake.StartMonitor(ctx, snowflake.Default(), rdb, b.bc.Snowflake.MonitorInterval.AsDuration())
+		b.cleanups = append(b.cleanups, func() {
+			cancel()
+			rdb.Close()
+		})
+	}
+	return nil
+}
+
+// initDefaultSnowflake 配置了区域时使用区域的epoch，否则使用start_time
+func initDefaultSnowflake(c *conf.Snowflake) error {
+	if c.GetRegion() == "" {
+		return snowflake.Init(c.GetStartTime(), c.GetMachineId())
+	}
+	epochMillis, err := epoch.EpochForRegion(c.GetRegion())
+	if err != nil {
+		return err
+	}
+	return snowflake.InitWithEpoch(epochMillis, c.GetMachineId())
+}
+
+func (b *bootstrap) initServers(context.Context) error {
+	app, cleanup, err := wireApp(b.bc.Server, b.rc, b.bc.Data, b.bc.Business, b.logger)
+	if err != nil {
+		return err
+	}
+	b.app = app
+	b.cleanups = append(b.cleanups, cleanup)
+	return nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"review-service/internal/conf"
+	"review-service/pkg/snowflake"
+	"review-service/pkg/snowflake/epoch"
+	"review-service/pkg/startup"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestBootstrapStartupOrder(t *testing.T) {
+	mr := miniredis.RunT(t)
+	// 关掉一个miniredis拿到一个没有监听的地址
+	down := miniredis.RunT(t)
+	downAddr := down.Addr()
+	down.Close()
+	missingDB := "file:" + filepath.Join(t.TempDir(), "missing", "review.db") + "?mode=ro"
+
+	tests := []struct {
+		name        string
+		dbSource    string
+		redisAddr   string
+		wantFailed  string
+		wantSkipped []string
+	}{
+		{"db down", missingDB, mr.Addr(), "db", []string{"redis", "snowflake", "servers"}},
+		{"redis down", "file:TestBootstrapStartupOrder?mode=memory&cache=shared", downAddr, "redis", []string{"snowflake", "servers"}},
+		{"all up", "file:TestBootstrapStartupOrder?mode=memory&cache=shared", mr.Addr(), "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bc := &conf.Bootstrap{
+				Server: &conf.Server{
+					Http: &conf.Server_HTTP{Addr: "127.0.0.1:0"},
+					Grpc: &conf.Server_GRPC{Addr: "127.0.0.1:0"},
+				},
+				Data: &conf.Data{
+					Database: &conf.Data_Database{Driver: "sqlite", Source: tt.dbSource},
+					Redis:    &conf.Data_Redis{Addr: tt.redisAddr},
+				},
+				Snowflake: &conf.Snowflake{StartTime: "2026-01-01", MachineId: 1},
+				Business:  &conf.Business{},
+			}
+			rc := &conf.Registry{Consul: &conf.Registry_Consul{Address: "127.0.0.1:8500", Scheme: "http"}}
+			boot := newBootstrap(bc, rc, log.DefaultLogger)
+			defer boot.close()
+
+			app, err := boot.run()
+			if tt.wantFailed == "" {
+				if err != nil || app == nil {
+					t.Fatalf("run() = (%v, %v), want an app", app, err)
+				}
+				return
+			}
+			var stageErr *startup.StageError
+			if !errors.As(err, &stageErr) {
+				t.Fatalf("run() err = %v, want *startup.StageError", err)
+			}
+			if _, ok := stageErr.Failed[tt.wantFailed]; !ok || len(stageErr.Failed) != 1 {
+				t.Fatalf("failed stages = %v, want only %s", stageErr.Failed, tt.wantFailed)
+			}
+			if !reflect.DeepEqual(stageErr.Skipped, tt.wantSkipped) {
+				t.Fatalf("skipped stages = %v, want %v", stageErr.Skipped, tt.wantSkipped)
+			}
+			if app != nil || boot.app != nil {
+				t.Fatal("servers created although a dependency is down")
+			}
+		})
+	}
+}
+
+// TestInitDefaultSnowflakeRegion 配置区域时ID的时间戳从该区域的epoch开始计算
+func TestInitDefaultSnowflakeRegion(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *conf.Snowflake
+		epoch  int64
+	}{
+		{"start time", &conf.Snowflake{StartTime: "2023-01-01", MachineId: 1}, 1672531200000},
+		{"region", &conf.Snowflake{StartTime: "2023-01-01", MachineId: 1, Region: "us"}, epoch.USEpoch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := initDefaultSnowflake(tt.config); err != nil {
+				t.Fatalf("initDefaultSnowflake fail, err:%v", err)
+			}
+			before := time.Now().UnixMilli()
+			id := snowflake.GenID()
+			after := time.Now().UnixMilli()
+			if got := id>>22 + tt.epoch; got < before || got > after {
+				t.Fatalf("id timestamp from epoch %d = %d, want within [%d, %d]", tt.epoch, got, before, after)
+			}
+		})
+	}
+	if err := initDefaultSnowflake(&conf.Snowflake{MachineId: 1, Region: "mars"}); err == nil {
+		t.Fatal("initDefaultSnowflake with unknown region succeeded, want error")
+	}
+}
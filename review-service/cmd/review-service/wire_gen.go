@@ -23,23 +23,62 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, business *conf.Business, logger log.Logger) (*kratos.App, func(), error) {
 	registrar := server.NewRegistrar(registry)
+	lagMonitor, cleanup, err := data.NewLagMonitor(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
 	db, err := data.NewDB(confData)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
-	dataData, cleanup, err := data.NewData(db, logger)
+	client := data.NewRedisClient(confData)
+	dataData, cleanup2, err := data.NewData(db, client, logger)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
-	reviewRepo := data.NewReviewRepo(dataData, logger)
-	reviewUsecase := biz.NewReviewUsecase(reviewRepo, logger)
-	reviewService := service.NewReviewService(reviewUsecase)
-	grpcServer := server.NewGRPCServer(confServer, reviewService, logger)
+	reviewRepo, cleanup3, err := data.NewReviewRepo(confData, dataData, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	moderationQueueRepo := data.NewModerationQueueRepo(dataData, logger)
+	reviewPriorityQueue := biz.NewReviewPriorityQueue(moderationQueueRepo, logger)
+	reviewAnomalyDetector := biz.NewReviewAnomalyDetector(reviewRepo, logger)
+	reviewUsecase := biz.NewReviewUsecase(reviewRepo, reviewPriorityQueue, reviewAnomalyDetector, business, logger)
+	reportRepo := data.NewReportRepo(dataData, logger)
+	reportUsecase := biz.NewReportUsecase(reportRepo, logger)
+	reviewSessionRepo := data.NewReviewSessionRepo(dataData, logger)
+	complianceUsecase := biz.NewComplianceUsecase(reviewRepo, reviewSessionRepo, logger)
+	tagTrendRepo := data.NewTagTrendRepo(dataData, logger)
+	tagTrendUsecase := biz.NewTagTrendUsecase(tagTrendRepo, logger)
+	reviewSessionUsecase := biz.NewReviewSessionUsecase(reviewSessionRepo, reviewUsecase, logger)
+	reviewService := service.NewReviewService(reviewUsecase, reviewPriorityQueue, reportUsecase, complianceUsecase, tagTrendUsecase, reviewSessionUsecase)
+	grpcServer := server.NewGRPCServer(confServer, confData, lagMonitor, reviewService, logger)
 	httpServer := server.NewHTTPServer(confServer, reviewService, logger)
-	app := newApp(logger, registrar, grpcServer, httpServer)
+	rollupRepo := data.NewRollupRepo(dataData, logger)
+	rollupUsecase := biz.NewRollupUsecase(rollupRepo, logger)
+	rollupJob := biz.NewRollupJob(rollupUsecase, logger)
+	sentimentRepo := data.NewSentimentRepo(dataData, logger)
+	alertNotifier := data.NewAlertNotifier(logger)
+	sentimentAlertJob := biz.NewSentimentAlertJob(sentimentRepo, alertNotifier, logger)
+	replicationLagProbe, cleanup4, err := data.NewReplicationLagProbe(confData, db, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	mainConfigReloader := newConfigReloader(reviewUsecase, logger)
+	app := newApp(logger, registrar, grpcServer, httpServer, rollupJob, sentimentAlertJob, reviewAnomalyDetector, replicationLagProbe, mainConfigReloader)
 	return app, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,117 @@
+package biz
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"review-service/pkg/anomaly"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 用孤立森林给新评价打异常分，分数高的评价由运营人工检查
+// 森林用最近的评价训练，每小时重新训练一次，训练完成前所有评价的异常分为0
+
+const (
+	// anomalyTrainingSize 训练使用的最近评价数
+	anomalyTrainingSize = 4096
+	// anomalyRefitInterval 重新训练的间隔
+	anomalyRefitInterval = time.Hour
+	// anomalyListLimit 异常评价列表最多返回的条数
+	anomalyListLimit = 100
+)
+
+// ReviewAnomalyDetector 评价异常检测，实现了transport.Server，随kratos.App启停定时重新训练
+type ReviewAnomalyDetector struct {
+	repo   ReviewRepo
+	forest atomic.Pointer[anomaly.Forest]
+	log    *log.Helper
+	stop   chan struct{}
+}
+
+func NewReviewAnomalyDetector(repo ReviewRepo, logger log.Logger) *ReviewAnomalyDetector {
+	return &ReviewAnomalyDetector{
+		repo: repo,
+		log:  log.NewHelper(logger),
+		stop: make(chan struct{}),
+	}
+}
+
+// reviewFeatures 评价的特征向量：评分、内容长度、提交时间（一天中的小时）、是否有图或视频
+// 还未入库的评价没有创建时间，按当前时间计算
+func reviewFeatures(review *model.ReviewInfo) []float64 {
+	createAt := review.CreateAt
+	if createAt.IsZero() {
+		createAt = time.Now()
+	}
+	var hasMedia float64
+	if review.PicInfo != "" || review.VideoInfo != "" {
+		hasMedia = 1
+	}
+	return []float64{
+		float64(review.Score),
+		float64(utf8.RuneCountInString(review.Content)),
+		float64(createAt.Hour()) + float64(createAt.Minute())/60,
+		hasMedia,
+	}
+}
+
+// Refit 用最近的评价重新训练
+func (d *ReviewAnomalyDetector) Refit(ctx context.Context) error {
+	reviews, err := d.repo.ListRecentReviews(ctx, anomalyTrainingSize)
+	if err != nil {
+		return err
+	}
+	if len(reviews) == 0 {
+		return nil
+	}
+	samples := make([][]float64, 0, len(reviews))
+	for _, r := range reviews {
+		samples = append(samples, reviewFeatures(r))
+	}
+	d.forest.Store(anomaly.NewForest(samples))
+	d.log.WithContext(ctx).Infof("[biz] ReviewAnomalyDetector refit done, samples:%d", len(samples))
+	return nil
+}
+
+// Score 评价的异常分数，取值[0,1]
+func (d *ReviewAnomalyDetector) Score(review *model.ReviewInfo) float64 {
+	return d.forest.Load().Detect(reviewFeatures(review))
+}
+
+// Start 启动时训练一次，之后按间隔重新训练，阻塞运行直到Stop被调用或ctx取消
+func (d *ReviewAnomalyDetector) Start(ctx context.Context) error {
+	ticker := time.NewTicker(anomalyRefitInterval)
+	defer ticker.Stop()
+	for {
+		if err := d.Refit(ctx); err != nil {
+			d.log.Errorf("ReviewAnomalyDetector refit fail, err:%v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-d.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *ReviewAnomalyDetector) Stop(context.Context) error {
+	close(d.stop)
+	return nil
+}
+
+// ListAnomalousReviews 店铺下异常分数不低于threshold的评价，按异常分数从高到低
+func (uc *ReviewUsecase) ListAnomalousReviews(ctx context.Context, storeID int64, threshold float64) ([]*model.ReviewInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListAnomalousReviews storeID:%v threshold:%v", storeID, threshold)
+	reviews, err := uc.repo.ListAnomalousReviews(ctx, storeID, threshold, anomalyListLimit)
+	if err != nil {
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+	return reviews, nil
+}
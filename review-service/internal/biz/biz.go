@@ -3,4 +3,4 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewReviewUsecase)
+var ProviderSet = wire.NewSet(NewReviewUsecase, NewReviewPriorityQueue, NewReviewAnomalyDetector, NewReviewSessionUsecase, NewRollupUsecase, NewReportUsecase, NewComplianceUsecase, NewTagTrendUsecase, NewRollupJob, NewSentimentAlertJob)
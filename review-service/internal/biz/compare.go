@@ -0,0 +1,54 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "review-service/api/review/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+// 对比店铺两个时间段的评价数据，例如活动前后
+
+// DateRange 时间段[From, To)
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// PeriodStats 一个时间段内的评价统计，已撤回的评价不参与统计
+type PeriodStats struct {
+	AvgScore    float64
+	ReviewCount int64
+}
+
+// PeriodComparison 两个时间段的评价统计
+type PeriodComparison struct {
+	PeriodA *PeriodStats
+	PeriodB *PeriodStats
+}
+
+// CompareReviewPeriods 并发查询两个时间段的统计
+func (uc *ReviewUsecase) CompareReviewPeriods(ctx context.Context, storeID int64, periodA, periodB DateRange) (*PeriodComparison, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CompareReviewPeriods storeID:%v periodA:%v periodB:%v", storeID, periodA, periodB)
+	if !periodA.From.Before(periodA.To) || !periodB.From.Before(periodB.To) {
+		return nil, errors.BadRequest("INVALID_PERIOD", "统计开始时间必须早于结束时间")
+	}
+	ret := new(PeriodComparison)
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		ret.PeriodA, err = uc.repo.GetPeriodStats(gctx, storeID, periodA.From, periodA.To)
+		return err
+	})
+	g.Go(func() (err error) {
+		ret.PeriodB, err = uc.repo.GetPeriodStats(gctx, storeID, periodB.From, periodB.To)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		uc.log.WithContext(ctx).Errorf("CompareReviewPeriods fail, err:%v", err)
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+	return ret, nil
+}
@@ -0,0 +1,88 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"review-service/pkg/env"
+	"review-service/pkg/signature"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 用户个人数据导出（GDPR数据可携带权），包含评价服务保存的该用户的全部数据，已撤回的评价也包含在内
+// 评价服务只保存评价、撤回申请和未提交的评价会话（草稿），点赞、举报和通知偏好不在本服务，由各自的服务导出
+// 导出结果序列化成JSON并用平台密钥做HMAC-SHA256签名，用户可以凭签名证明数据来自平台
+
+// exportSigningKeyEnv 签名密钥的环境变量，未设置时不能导出
+const exportSigningKeyEnv = "DATA_EXPORT_SIGNING_KEY"
+
+// UserDataExport 用户的个人数据
+type UserDataExport struct {
+	UserID      int64                         `json:"user_id"`
+	ExportedAt  time.Time                     `json:"exported_at"`
+	Reviews     []*model.ReviewInfo           `json:"reviews"`
+	Withdrawals []*model.ReviewWithdrawalInfo `json:"withdrawals"`
+	// Drafts 还没有提交的分步评价会话
+	Drafts []*ReviewSession `json:"drafts"`
+}
+
+type ComplianceUsecase struct {
+	repo       ReviewRepo
+	sessions   ReviewSessionRepo
+	signingKey []byte
+	log        *log.Helper
+}
+
+func NewComplianceUsecase(repo ReviewRepo, sessions ReviewSessionRepo, logger log.Logger) *ComplianceUsecase {
+	return &ComplianceUsecase{
+		repo:       repo,
+		sessions:   sessions,
+		signingKey: []byte(env.OptionalString(exportSigningKeyEnv, "")),
+		log:        log.NewHelper(logger),
+	}
+}
+
+// ExportUserData 查询用户的全部个人数据
+func (uc *ComplianceUsecase) ExportUserData(ctx context.Context, userID int64) (*UserDataExport, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ExportUserData userID:%v", userID)
+	reviews, err := uc.repo.ListAllReviewByUserID(ctx, userID)
+	if err != nil {
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+	withdrawals, err := uc.repo.ListWithdrawalByUserID(ctx, userID)
+	if err != nil {
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+	drafts, err := uc.sessions.ListReviewSessionByUserID(ctx, userID)
+	if err != nil {
+		return nil, v1.ErrorDbFailed("查询评价会话失败")
+	}
+	return &UserDataExport{
+		UserID:      userID,
+		ExportedAt:  time.Now(),
+		Reviews:     reviews,
+		Withdrawals: withdrawals,
+		Drafts:      drafts,
+	}, nil
+}
+
+// SignedUserDataExport 导出用户数据，返回JSON和签名
+func (uc *ComplianceUsecase) SignedUserDataExport(ctx context.Context, userID int64) ([]byte, string, error) {
+	if len(uc.signingKey) == 0 {
+		return nil, "", errors.ServiceUnavailable("DATA_EXPORT_DISABLED", "未配置数据导出签名密钥")
+	}
+	export, err := uc.ExportUserData(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	b, err := json.Marshal(export)
+	if err != nil {
+		return nil, "", err
+	}
+	return b, signature.Sign(uc.signingKey, b), nil
+}
@@ -0,0 +1,79 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"review-service/internal/data/model"
+	"review-service/pkg/signature"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestSignedUserDataExport(t *testing.T) {
+	reviews := newFakeReviewRepo(
+		&model.ReviewInfo{ReviewID: 1, UserID: 7, Content: "good", Status: ReviewStatusApproved},
+		&model.ReviewInfo{ReviewID: 2, UserID: 7, Content: "withdrawn", Status: ReviewStatusWithdrawn},
+		&model.ReviewInfo{ReviewID: 3, UserID: 8, Content: "other user"},
+	)
+	reviews.withdrawals[10] = &model.ReviewWithdrawalInfo{WithdrawalID: 10, ReviewID: 2, UserID: 7}
+	sessions := newFakeReviewSessionRepo()
+	ctx := context.Background()
+	for _, session := range []*ReviewSession{
+		{SessionID: "draft", UserID: 7, PartialData: map[string]interface{}{"score": 5}},
+		{SessionID: "other", UserID: 8},
+	} {
+		if err := sessions.SaveReviewSession(ctx, session, ReviewSessionTTL); err != nil {
+			t.Fatalf("SaveReviewSession fail, err:%v", err)
+		}
+	}
+	uc := NewComplianceUsecase(reviews, sessions, log.DefaultLogger)
+	key := []byte("test-signing-key")
+	uc.signingKey = key
+
+	b, sig, err := uc.SignedUserDataExport(ctx, 7)
+	if err != nil {
+		t.Fatalf("SignedUserDataExport fail, err:%v", err)
+	}
+	if !signature.Verify(key, b, sig) {
+		t.Fatalf("signature %q does not verify the export", sig)
+	}
+
+	var export UserDataExport
+	if err := json.Unmarshal(b, &export); err != nil {
+		t.Fatalf("unmarshal export fail, err:%v", err)
+	}
+	if export.UserID != 7 || len(export.Reviews) != 2 || len(export.Withdrawals) != 1 || len(export.Drafts) != 1 || export.Drafts[0].SessionID != "draft" {
+		t.Fatalf("export = %s, want 2 reviews, 1 withdrawal and draft session of user 7", b)
+	}
+
+	tests := []struct {
+		name string
+		key  []byte
+		data []byte
+		sig  string
+	}{
+		{"tampered data", key, bytes.Replace(b, []byte(`"good"`), []byte(`"bad!"`), 1), sig},
+		{"truncated data", key, b[:len(b)-1], sig},
+		{"wrong key", []byte("other-key"), b, sig},
+		{"malformed signature", key, b, "not-hex"},
+		{"empty signature", key, b, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if signature.Verify(tt.key, tt.data, tt.sig) {
+				t.Fatal("Verify() = true, want false")
+			}
+		})
+	}
+}
+
+func TestSignedUserDataExportWithoutKey(t *testing.T) {
+	uc := NewComplianceUsecase(newFakeReviewRepo(), newFakeReviewSessionRepo(), log.DefaultLogger)
+	uc.signingKey = nil
+	if _, _, err := uc.SignedUserDataExport(context.Background(), 7); err == nil {
+		t.Fatal("SignedUserDataExport without signing key succeeded, want error")
+	}
+}
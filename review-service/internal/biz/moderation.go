@@ -0,0 +1,75 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"review-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 按紧急程度排序的店铺待审核评价队列
+// urgency = (6 - score)*100 + reportCount*10 + 提交时间到urgencyAgeBase的小时数
+// 低分评价优先，其次是被举报多的，同等条件下等待越久越优先
+// 分数在入队时写入有序集合后不再更新，所以等待时长不能用"当前时间-提交时间"（入队时总是约等于0，
+// 会变成后提交的先出队），而是用固定的基准时间减去提交时间：两条评价这一项的差值就是它们提交时间的差，
+// 与在任意时刻计算等待时长的排序结果相同
+
+// urgencyAgeBase 计算等待时长项的基准时间，要晚于所有评价的提交时间
+var urgencyAgeBase = time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// ModerationQueueRepo 待审核队列存储，PopModeration在队列为空时返回0
+type ModerationQueueRepo interface {
+	PushModeration(ctx context.Context, storeID, reviewID int64, urgency float64) error
+	PopModeration(ctx context.Context, storeID int64) (int64, error)
+	// RemoveModeration 评价不在队列中时不报错
+	RemoveModeration(ctx context.Context, storeID, reviewID int64) error
+	ModerationDepth(ctx context.Context, storeID int64) (int64, error)
+}
+
+type ReviewPriorityQueue struct {
+	repo ModerationQueueRepo
+	log  *log.Helper
+}
+
+func NewReviewPriorityQueue(repo ModerationQueueRepo, logger log.Logger) *ReviewPriorityQueue {
+	return &ReviewPriorityQueue{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// EnqueueForModeration 评价加入所属店铺的待审核队列
+func (q *ReviewPriorityQueue) EnqueueForModeration(ctx context.Context, review *model.ReviewInfo) error {
+	// TODO 目前还没有举报功能，举报数按0计算
+	urgency := moderationUrgency(review.Score, 0, review.CreateAt, time.Now())
+	q.log.WithContext(ctx).Debugf("[biz] EnqueueForModeration reviewID:%v storeID:%v urgency:%v", review.ReviewID, review.StoreID, urgency)
+	return q.repo.PushModeration(ctx, review.StoreID, review.ReviewID, urgency)
+}
+
+// DequeueNextForModeration 取出店铺队列中最紧急的评价，队列为空时返回0
+func (q *ReviewPriorityQueue) DequeueNextForModeration(ctx context.Context, storeID int64) (int64, error) {
+	q.log.WithContext(ctx).Debugf("[biz] DequeueNextForModeration storeID:%v", storeID)
+	return q.repo.PopModeration(ctx, storeID)
+}
+
+// RemoveFromModeration 评价已审核或已撤回，从店铺的待审核队列中移除，避免再被取出
+func (q *ReviewPriorityQueue) RemoveFromModeration(ctx context.Context, storeID, reviewID int64) error {
+	q.log.WithContext(ctx).Debugf("[biz] RemoveFromModeration reviewID:%v storeID:%v", reviewID, storeID)
+	return q.repo.RemoveModeration(ctx, storeID, reviewID)
+}
+
+// GetQueueDepth 店铺队列中待审核的评价数
+func (q *ReviewPriorityQueue) GetQueueDepth(ctx context.Context, storeID int64) (int64, error) {
+	return q.repo.ModerationDepth(ctx, storeID)
+}
+
+// moderationUrgency 计算紧急程度，createAt为零值时按now提交处理
+func moderationUrgency(score int32, reportCount int64, createAt, now time.Time) float64 {
+	if createAt.IsZero() {
+		createAt = now
+	}
+	hours := urgencyAgeBase.Sub(createAt).Hours()
+	return float64(6-score)*100 + float64(reportCount)*10 + hours
+}
@@ -0,0 +1,147 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"review-service/internal/data/model"
+)
+
+// fakeModerationQueueRepo 内存实现的待审核队列
+type fakeModerationQueueRepo struct {
+	mu     sync.Mutex
+	stores map[int64]map[int64]float64
+}
+
+func newFakeModerationQueueRepo() *fakeModerationQueueRepo {
+	return &fakeModerationQueueRepo{stores: make(map[int64]map[int64]float64)}
+}
+
+func (r *fakeModerationQueueRepo) PushModeration(_ context.Context, storeID, reviewID int64, urgency float64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stores[storeID] == nil {
+		r.stores[storeID] = make(map[int64]float64)
+	}
+	r.stores[storeID][reviewID] = urgency
+	return nil
+}
+
+func (r *fakeModerationQueueRepo) PopModeration(_ context.Context, storeID int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var (
+		top     int64
+		urgency float64
+	)
+	for reviewID, u := range r.stores[storeID] {
+		if top == 0 || u > urgency {
+			top, urgency = reviewID, u
+		}
+	}
+	delete(r.stores[storeID], top)
+	return top, nil
+}
+
+func (r *fakeModerationQueueRepo) RemoveModeration(_ context.Context, storeID, reviewID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.stores[storeID], reviewID)
+	return nil
+}
+
+func (r *fakeModerationQueueRepo) ModerationDepth(_ context.Context, storeID int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return int64(len(r.stores[storeID])), nil
+}
+
+func TestModerationUrgencyOrdering(t *testing.T) {
+	t0 := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	// 每条评价都在提交时入队，now等于createAt
+	enqueue := func(score int32, reportCount int64, createAt time.Time) float64 {
+		return moderationUrgency(score, reportCount, createAt, createAt)
+	}
+	older := enqueue(4, 0, t0)
+	newer := enqueue(4, 0, t0.Add(2*time.Hour))
+	if older <= newer {
+		t.Fatalf("older review urgency %v should be greater than newer %v", older, newer)
+	}
+	if diff := older - newer; diff != 2 {
+		t.Fatalf("urgency diff = %v, want 2 (hours between submissions)", diff)
+	}
+	if oneStar := enqueue(1, 0, t0.Add(3*time.Hour)); oneStar <= older {
+		t.Fatalf("1-star review urgency %v should be greater than 4-star %v", oneStar, older)
+	}
+	if reported := enqueue(4, 1, t0.Add(5*time.Hour)); reported <= older {
+		t.Fatalf("reported review urgency %v should be greater than unreported %v", reported, older)
+	}
+	// createAt为零值时按入队时间计算
+	if got, want := moderationUrgency(4, 0, time.Time{}, t0), older; got != want {
+		t.Fatalf("zero createAt urgency = %v, want %v", got, want)
+	}
+}
+
+func TestAuditedReviewsLeaveModerationQueue(t *testing.T) {
+	const storeID = 10
+	t0 := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	reviews := []*model.ReviewInfo{
+		{ReviewID: 1, StoreID: storeID, Score: 1, Status: 10, CreateAt: t0},
+		{ReviewID: 2, StoreID: storeID, Score: 3, Status: 10, CreateAt: t0},
+		{ReviewID: 3, StoreID: storeID, Score: 5, Status: 10, CreateAt: t0},
+	}
+	tests := []struct {
+		name    string
+		audited []int64
+		want    []int64
+	}{
+		{"none audited", nil, []int64{1, 2, 3}},
+		{"most urgent audited", []int64{1}, []int64{2, 3}},
+		{"all audited", []int64{1, 2, 3}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			var copied []*model.ReviewInfo
+			for _, review := range reviews {
+				r := *review
+				copied = append(copied, &r)
+			}
+			uc := newTestReviewUsecase(newFakeReviewRepo(copied...))
+			for _, review := range copied {
+				if err := uc.queue.EnqueueForModeration(ctx, review); err != nil {
+					t.Fatalf("EnqueueForModeration err: %v", err)
+				}
+			}
+			for _, reviewID := range tt.audited {
+				if err := uc.AuditReview(ctx, &AuditParam{ReviewID: reviewID, Status: ReviewStatusApproved, OpUser: "op"}); err != nil {
+					t.Fatalf("AuditReview(%d) err: %v", reviewID, err)
+				}
+			}
+			if depth, _ := uc.queue.GetQueueDepth(ctx, storeID); depth != int64(len(tt.want)) {
+				t.Fatalf("queue depth = %d, want %d", depth, len(tt.want))
+			}
+			var got []int64
+			for {
+				reviewID, err := uc.queue.DequeueNextForModeration(ctx, storeID)
+				if err != nil {
+					t.Fatalf("DequeueNextForModeration err: %v", err)
+				}
+				if reviewID == 0 {
+					break
+				}
+				got = append(got, reviewID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("dequeued %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("dequeued %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -36,3 +36,18 @@ type AuditAppealParam struct {
 	OpReason string
 	Status   int32
 }
+
+// BulkFilter 批量操作评价的筛选条件，零值表示不限制该条件
+type BulkFilter struct {
+	StoreID  int64 `json:"store_id,omitempty"`
+	Status   int32 `json:"status,omitempty"`
+	MinScore int32 `json:"min_score,omitempty"`
+	MaxScore int32 `json:"max_score,omitempty"`
+}
+
+// AuditWithdrawalParam O端审核撤回申请的参数
+type AuditWithdrawalParam struct {
+	WithdrawalID int64
+	OpUser       string
+	OpRemarks    string
+}
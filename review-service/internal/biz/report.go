@@ -0,0 +1,133 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"time"
+
+	v1 "review-service/api/review/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// 全平台评价统计，给BI使用，只返回聚合结果不返回具体评价
+// 已撤回的评价不参与统计
+
+const (
+	// PlatformReportTTL 统计结果缓存时间
+	PlatformReportTTL = time.Hour
+	// platformReportTopKeywords 报表返回的热门关键词数
+	platformReportTopKeywords = 10
+)
+
+// PlatformReport 全平台评价统计
+type PlatformReport struct {
+	TotalReviews int64   `json:"total_reviews"`
+	AvgScore     float64 `json:"avg_score"`
+	// ApprovalRate 审核通过数 / 已审核数（通过+不通过），没有已审核的评价时为0
+	ApprovalRate float64 `json:"approval_rate"`
+	// MostActiveStore 评价数最多的店铺，没有评价时为0
+	MostActiveStore int64 `json:"most_active_store"`
+	// TopKeywords 评价关键词索引中出现次数最多的关键词，从多到少
+	TopKeywords []string `json:"top_keywords"`
+	// DAUReviewers 每天评价的去重用户数在统计区间内按天平均
+	DAUReviewers int64 `json:"dau_reviewers"`
+}
+
+// ReportRepo 统计区间均为[from, to)
+type ReportRepo interface {
+	CountReviews(ctx context.Context, from, to time.Time) (int64, error)
+	AvgScore(ctx context.Context, from, to time.Time) (float64, error)
+	CountAudited(ctx context.Context, from, to time.Time) (approved, rejected int64, err error)
+	MostActiveStore(ctx context.Context, from, to time.Time) (int64, error)
+	// TopKeywords 从评价关键词索引中取[from, to)内出现次数最多的n个关键词
+	TopKeywords(ctx context.Context, from, to time.Time, n int) ([]string, error)
+	// DailyReviewers 每天评价的去重用户数，没有评价的天不返回
+	DailyReviewers(ctx context.Context, from, to time.Time) ([]int64, error)
+	// GetCachedPlatformReport 缓存不存在时返回(nil, nil)
+	GetCachedPlatformReport(ctx context.Context, from, to time.Time) (*PlatformReport, error)
+	SavePlatformReport(ctx context.Context, from, to time.Time, report *PlatformReport, ttl time.Duration) error
+}
+
+type ReportUsecase struct {
+	repo ReportRepo
+	log  *log.Helper
+}
+
+func NewReportUsecase(repo ReportRepo, logger log.Logger) *ReportUsecase {
+	return &ReportUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetPlatformReport 统计[from, to)内的全平台评价数据，各项指标并发查询，结果缓存1小时
+func (uc *ReportUsecase) GetPlatformReport(ctx context.Context, from, to time.Time) (*PlatformReport, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetPlatformReport from:%v to:%v", from, to)
+	if !from.Before(to) {
+		return nil, errors.BadRequest("INVALID_PERIOD", "统计开始时间必须早于结束时间")
+	}
+	if report, err := uc.repo.GetCachedPlatformReport(ctx, from, to); err != nil {
+		uc.log.WithContext(ctx).Warnf("GetCachedPlatformReport fail, err:%v", err)
+	} else if report != nil {
+		return report, nil
+	}
+
+	report := new(PlatformReport)
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		report.TotalReviews, err = uc.repo.CountReviews(gctx, from, to)
+		return err
+	})
+	g.Go(func() (err error) {
+		report.AvgScore, err = uc.repo.AvgScore(gctx, from, to)
+		return err
+	})
+	g.Go(func() error {
+		approved, rejected, err := uc.repo.CountAudited(gctx, from, to)
+		if err != nil {
+			return err
+		}
+		if approved+rejected > 0 {
+			report.ApprovalRate = float64(approved) / float64(approved+rejected)
+		}
+		return nil
+	})
+	g.Go(func() (err error) {
+		report.MostActiveStore, err = uc.repo.MostActiveStore(gctx, from, to)
+		return err
+	})
+	g.Go(func() (err error) {
+		report.TopKeywords, err = uc.repo.TopKeywords(gctx, from, to, platformReportTopKeywords)
+		return err
+	})
+	g.Go(func() error {
+		daily, err := uc.repo.DailyReviewers(gctx, from, to)
+		if err != nil {
+			return err
+		}
+		report.DAUReviewers = averagePerDay(daily, from, to)
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		uc.log.WithContext(ctx).Errorf("GetPlatformReport fail, err:%v", err)
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+
+	if err := uc.repo.SavePlatformReport(ctx, from, to, report, PlatformReportTTL); err != nil {
+		uc.log.WithContext(ctx).Warnf("SavePlatformReport fail, err:%v", err)
+	}
+	return report, nil
+}
+
+// averagePerDay 按统计区间的天数（不足一天按一天）求平均，没有评价的天按0计算
+func averagePerDay(daily []int64, from, to time.Time) int64 {
+	days := math.Ceil(to.Sub(from).Hours() / 24)
+	var sum int64
+	for _, n := range daily {
+		sum += n
+	}
+	return int64(math.Round(float64(sum) / days))
+}
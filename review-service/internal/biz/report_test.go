@@ -0,0 +1,136 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeReportRepo 返回固定统计结果的ReportRepo
+type fakeReportRepo struct {
+	total            int64
+	avg              float64
+	approved         int64
+	rejected         int64
+	store            int64
+	keywords         []string
+	daily            []int64
+	cached           *PlatformReport
+	saved            *PlatformReport
+	keywordsRequired int
+}
+
+func (r *fakeReportRepo) CountReviews(context.Context, time.Time, time.Time) (int64, error) {
+	return r.total, nil
+}
+
+func (r *fakeReportRepo) AvgScore(context.Context, time.Time, time.Time) (float64, error) {
+	return r.avg, nil
+}
+
+func (r *fakeReportRepo) CountAudited(context.Context, time.Time, time.Time) (int64, int64, error) {
+	return r.approved, r.rejected, nil
+}
+
+func (r *fakeReportRepo) MostActiveStore(context.Context, time.Time, time.Time) (int64, error) {
+	return r.store, nil
+}
+
+func (r *fakeReportRepo) TopKeywords(_ context.Context, _, _ time.Time, n int) ([]string, error) {
+	r.keywordsRequired = n
+	if len(r.keywords) > n {
+		return r.keywords[:n], nil
+	}
+	return r.keywords, nil
+}
+
+func (r *fakeReportRepo) DailyReviewers(context.Context, time.Time, time.Time) ([]int64, error) {
+	return r.daily, nil
+}
+
+func (r *fakeReportRepo) GetCachedPlatformReport(context.Context, time.Time, time.Time) (*PlatformReport, error) {
+	return r.cached, nil
+}
+
+func (r *fakeReportRepo) SavePlatformReport(_ context.Context, _, _ time.Time, report *PlatformReport, _ time.Duration) error {
+	r.saved = report
+	return nil
+}
+
+func TestGetPlatformReport(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		repo *fakeReportRepo
+		to   time.Time
+		want *PlatformReport
+	}{
+		{
+			name: "all fields",
+			repo: &fakeReportRepo{
+				total: 120, avg: 4.25, approved: 90, rejected: 10, store: 10086,
+				keywords: []string{"质量", "物流", "包装"},
+				// 3天共10人次，日均3.33
+				daily: []int64{4, 3, 3},
+			},
+			to: from.AddDate(0, 0, 3),
+			want: &PlatformReport{
+				TotalReviews: 120, AvgScore: 4.25, ApprovalRate: 0.9, MostActiveStore: 10086,
+				TopKeywords: []string{"质量", "物流", "包装"}, DAUReviewers: 3,
+			},
+		},
+		{
+			// 没有评价的天按0计算，不足一天按一天：4天共14人次，日均3.5四舍五入为4
+			name: "average rounds half up over days without reviews",
+			repo: &fakeReportRepo{total: 14, avg: 3, approved: 1, rejected: 3, store: 7, keywords: []string{"好评"}, daily: []int64{9, 5}},
+			to:   from.AddDate(0, 0, 3).Add(time.Hour),
+			want: &PlatformReport{
+				TotalReviews: 14, AvgScore: 3, ApprovalRate: 0.25, MostActiveStore: 7,
+				TopKeywords: []string{"好评"}, DAUReviewers: 4,
+			},
+		},
+		{
+			name: "no audited reviews",
+			repo: &fakeReportRepo{total: 2, avg: 5, store: 1, daily: []int64{2}},
+			to:   from.Add(12 * time.Hour),
+			want: &PlatformReport{TotalReviews: 2, AvgScore: 5, MostActiveStore: 1, DAUReviewers: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewReportUsecase(tt.repo, log.DefaultLogger)
+			got, err := uc.GetPlatformReport(context.Background(), from, tt.to)
+			if err != nil {
+				t.Fatalf("GetPlatformReport err: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("report = %+v, want %+v", got, tt.want)
+			}
+			if tt.repo.keywordsRequired != platformReportTopKeywords {
+				t.Fatalf("TopKeywords n = %d, want %d", tt.repo.keywordsRequired, platformReportTopKeywords)
+			}
+			if tt.repo.saved != got {
+				t.Fatalf("report not cached")
+			}
+		})
+	}
+}
+
+func TestGetPlatformReportCached(t *testing.T) {
+	cached := &PlatformReport{TotalReviews: 1}
+	uc := NewReportUsecase(&fakeReportRepo{cached: cached, total: 99}, log.DefaultLogger)
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	got, err := uc.GetPlatformReport(context.Background(), from, from.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("GetPlatformReport err: %v", err)
+	}
+	if got != cached {
+		t.Fatalf("report = %+v, want cached %+v", got, cached)
+	}
+	if _, err := uc.GetPlatformReport(context.Background(), from, from); err == nil {
+		t.Fatalf("empty period: want error")
+	}
+}
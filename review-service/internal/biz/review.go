@@ -2,12 +2,40 @@ package biz
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
 	"review-service/internal/data/model"
+	"review-service/pkg/budget"
+	pkgerrors "review-service/pkg/errors"
+	"review-service/pkg/metrics"
 	"review-service/pkg/snowflake"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// bulkTagBatchSize 批量打标签时每批处理的评价数
+const bulkTagBatchSize = 500
+
+// AuditActionBulkTag 运营操作日志中批量打标签的操作类型
+const AuditActionBulkTag = "bulk_tag"
+
+// 评价状态，与review_info.status一致
+const (
+	ReviewStatusApproved          = 20 // 审核通过
+	ReviewStatusRejected          = 30 // 审核不通过
+	ReviewStatusWithdrawalPending = 50 // 撤回审核中
+	ReviewStatusWithdrawn         = 60 // 已撤回，不再对外展示
 )
 
 type ReviewRepo interface {
@@ -20,18 +48,59 @@ type ReviewRepo interface {
 	AppealReview(context.Context, *AppealParam) error
 	AuditAppeal(context.Context, *AuditAppealParam) error
 	ListReviewByUserID(ctx context.Context, userID int64, offset, limit int) ([]*model.ReviewInfo, error)
+	ListReviewByStoreID(ctx context.Context, storeID int64, status int32, offset, limit int) ([]*model.ReviewInfo, error)
+	GetReviewTag(context.Context, int64) (*model.ReviewTagInfo, error)
+	ListReviewByFilter(ctx context.Context, filter *BulkFilter, afterID int64, limit int) ([]*model.ReviewInfo, error)
+	SaveReviewTagMaps(context.Context, []*model.ReviewTagMap) (int64, error)
+	GetWithdrawal(context.Context, int64) (*model.ReviewWithdrawalInfo, error)
+	GetWithdrawalByReviewID(context.Context, int64) ([]*model.ReviewWithdrawalInfo, error)
+	SaveWithdrawal(context.Context, *model.ReviewWithdrawalInfo) error
+	AuditWithdrawal(ctx context.Context, withdrawal *model.ReviewWithdrawalInfo, reviewStatus int32) error
+	GetProductStats(ctx context.Context, spuIDs []int64) ([]*ProductStatSummary, error)
+	ListRecentReviews(ctx context.Context, limit int) ([]*model.ReviewInfo, error)
+	ListAnomalousReviews(ctx context.Context, storeID int64, threshold float64, limit int) ([]*model.ReviewInfo, error)
+	GetPeriodStats(ctx context.Context, storeID int64, from, to time.Time) (*PeriodStats, error)
+	ListAllReviewByUserID(ctx context.Context, userID int64) ([]*model.ReviewInfo, error)
+	ListWithdrawalByUserID(ctx context.Context, userID int64) ([]*model.ReviewWithdrawalInfo, error)
+	SaveAuditLog(context.Context, *model.ReviewAuditLog) error
 }
 
+// ErrDuplicateContent 同一商品下已存在内容相同的评价，由data层在唯一索引冲突时返回
+var ErrDuplicateContent = errors.New("duplicate review content")
+
 type ReviewUsecase struct {
 	repo ReviewRepo
-	log  *log.Helper
+	// queue 新评价进入店铺的待审核队列
+	queue *ReviewPriorityQueue
+	// anomaly 新评价入库前打异常分
+	anomaly *ReviewAnomalyDetector
+	// business 当前生效的业务配置(*conf.Business)，收到SIGHUP时整体替换
+	business atomic.Value
+	log      *log.Helper
 }
 
-func NewReviewUsecase(repo ReviewRepo, logger log.Logger) *ReviewUsecase {
-	return &ReviewUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+func NewReviewUsecase(repo ReviewRepo, queue *ReviewPriorityQueue, anomaly *ReviewAnomalyDetector, cfg *conf.Business, logger log.Logger) *ReviewUsecase {
+	uc := &ReviewUsecase{
+		repo:    repo,
+		queue:   queue,
+		anomaly: anomaly,
+		log:     log.NewHelper(logger),
 	}
+	uc.Reload(cfg)
+	return uc
+}
+
+// Reload 替换业务配置，已经在处理中的请求继续使用开始时读到的配置
+func (uc *ReviewUsecase) Reload(cfg *conf.Business) {
+	if cfg == nil {
+		cfg = &conf.Business{}
+	}
+	uc.business.Store(cfg)
+}
+
+// businessConfig 当前生效的业务配置，一个请求内只读取一次
+func (uc *ReviewUsecase) businessConfig() *conf.Business {
+	return uc.business.Load().(*conf.Business)
 }
 
 // CreateReview 创建评价
@@ -39,10 +108,23 @@ func NewReviewUsecase(repo ReviewRepo, logger log.Logger) *ReviewUsecase {
 // service层调用该方法
 func (uc *ReviewUsecase) CreateReview(ctx context.Context, review *model.ReviewInfo) (*model.ReviewInfo, error) {
 	uc.log.WithContext(ctx).Debugf("[biz] CreateReview, req:%v", review)
+	start := time.Now()
+	business := uc.businessConfig()
 	// 1、数据校验
 	// 1.1 参数基础校验：正常来说不应该放在这一层，你在上一层或者框架层都应该能拦住（validate参数校验）
 	// 1.2 参数业务校验：带业务逻辑的参数校验，比如已经评价过的订单不能再创建评价
-	reviews, err := uc.repo.GetReviewByOrderID(ctx, review.OrderID)
+	if min := business.GetMinReviewLength(); utf8.RuneCountInString(review.Content) < int(min) {
+		return nil, v1.ErrorContentTooShort("评价内容不能少于%d个字", min)
+	}
+	// 下游调用都计入请求入口分配的耗时预算，预算用完后不再发起新的调用
+	var reviews []*model.ReviewInfo
+	err := budget.Call(ctx, func(ctx context.Context) (err error) {
+		reviews, err = uc.repo.GetReviewByOrderID(ctx, review.OrderID)
+		return err
+	})
+	if errors.Is(err, budget.ErrBudgetExceeded) {
+		return nil, err
+	}
 	if err != nil {
 		return nil, v1.ErrorDbFailed("查询数据库失败")
 	}
@@ -55,16 +137,53 @@ func (uc *ReviewUsecase) CreateReview(ctx context.Context, review *model.ReviewI
 	// 这里可以使用雪花算法自己生成
 	// 也可以直接接入公司内部的分布式ID生成服务（前提是公司内部有这种服务）
 	review.ReviewID = snowflake.GenID()
+	// 同一商品下的评价内容去重，防止刷评
+	if !business.GetAllowDuplicateContent() {
+		hash := contentHash(review.SpuID, review.Content)
+		review.ContentHash = &hash
+	}
+	// 异常检测打分，分数高的评价需要人工检查
+	review.AnomalyScore = uc.anomaly.Score(review)
 	// 3、查询订单和商品快照信息
 	// 实际业务场景下就需要查询订单服务和商家服务（比如说通过RPC调用订单服务和商家服务）
+	// 订单服务确认下单用户就是评价用户后才能把review.IsVerifiedPurchase置为1，没有确认的评价不算验证购买
 	// 4、拼装数据入库
-	return uc.repo.SaveReview(ctx, review)
+	err = budget.Call(ctx, func(ctx context.Context) (err error) {
+		review, err = uc.repo.SaveReview(ctx, review)
+		return err
+	})
+	if errors.Is(err, ErrDuplicateContent) {
+		return nil, v1.ErrorDuplicateContent("该商品下已存在相同内容的评价")
+	}
+	if err != nil {
+		return nil, err
+	}
+	// 5、加入待审核队列，入队同样计入耗时预算，入队失败不影响评价创建
+	err = budget.Call(ctx, func(ctx context.Context) error {
+		return uc.queue.EnqueueForModeration(ctx, review)
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("EnqueueForModeration fail, reviewID:%v err:%v", review.ReviewID, err)
+	}
+	metrics.Default().ReviewCreated(time.Since(start))
+	return review, nil
 }
 
 // GetReview 根据评价ID获取评价
 func (uc *ReviewUsecase) GetReview(ctx context.Context, reviewID int64) (*model.ReviewInfo, error) {
 	uc.log.WithContext(ctx).Debugf("[biz] GetReview reviewID:%v", reviewID)
-	return uc.repo.GetReview(ctx, reviewID)
+	review, err := uc.repo.GetReview(ctx, reviewID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFoundError("review", reviewID)
+		}
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+	// 已撤回的评价不再对外展示
+	if review.Status == ReviewStatusWithdrawn {
+		return nil, pkgerrors.NotFoundError("review", reviewID)
+	}
+	return review, nil
 }
 
 // CreateReply 创建评价回复
@@ -79,13 +198,60 @@ func (uc *ReviewUsecase) CreateReply(ctx context.Context, param *ReplyParam) (*m
 		PicInfo:   param.PicInfo,
 		VideoInfo: param.VideoInfo,
 	}
-	return uc.repo.SaveReply(ctx, reply)
+	reply, err := uc.repo.SaveReply(ctx, reply)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, pkgerrors.NotFoundError("review", param.ReviewID)
+	}
+	return reply, err
 }
 
 // AuditReview 审核评价
 func (uc *ReviewUsecase) AuditReview(ctx context.Context, param *AuditParam) error {
 	uc.log.WithContext(ctx).Debugf("[biz] AuditReview param:%v", param)
-	return uc.repo.AuditReview(ctx, param)
+	review, err := uc.repo.GetReview(ctx, param.ReviewID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.NotFoundError("review", param.ReviewID)
+		}
+		return v1.ErrorDbFailed("查询数据库失败")
+	}
+	if err := uc.repo.AuditReview(ctx, param); err != nil {
+		if errors.Is(err, ErrStatusChanged) {
+			return v1.ErrorReviewNotAuditable("评价:%d撤回中或已撤回，不能审核", param.ReviewID)
+		}
+		return v1.ErrorDbFailed("写入数据库失败")
+	}
+	// 已审核的评价移出待审核队列，出队失败只影响队列深度，不影响审核结果
+	if err := uc.queue.RemoveFromModeration(ctx, review.StoreID, review.ReviewID); err != nil {
+		uc.log.WithContext(ctx).Warnf("RemoveFromModeration fail, reviewID:%v err:%v", review.ReviewID, err)
+	}
+	switch param.Status {
+	case ReviewStatusApproved:
+		metrics.Default().ReviewApproved()
+	case ReviewStatusRejected:
+		metrics.Default().ReviewRejected()
+	}
+	return nil
+}
+
+// ListReviewByUserID 分页查询用户的评价，不包含已撤回的评价
+func (uc *ReviewUsecase) ListReviewByUserID(ctx context.Context, userID int64, page, size int) ([]*model.ReviewInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListReviewByUserID userID:%v page:%v size:%v", userID, page, size)
+	reviews, err := uc.repo.ListReviewByUserID(ctx, userID, (page-1)*size, size)
+	if err != nil {
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+	return reviews, nil
+}
+
+// ListReviewByStoreID 分页查询店铺的评价，status为0时不限制状态
+func (uc *ReviewUsecase) ListReviewByStoreID(ctx context.Context, storeID int64, status int32, page, size int) ([]*model.ReviewInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListReviewByStoreID storeID:%v status:%v page:%v size:%v", storeID, status, page, size)
+	reviews, err := uc.repo.ListReviewByStoreID(ctx, storeID, status, (page-1)*size, size)
+	if err != nil {
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+	return reviews, nil
 }
 
 // AppealReview 申诉评价
@@ -93,3 +259,78 @@ func (uc ReviewUsecase) AppealReview(ctx context.Context, param *AppealParam) er
 	uc.log.WithContext(ctx).Debugf("[biz] AppealReview param:%v", param)
 	return uc.repo.AppealReview(ctx, param)
 }
+
+// BulkTagReviews 给符合筛选条件的评价批量打标签，返回新增的标签关联数
+// 操作人、筛选条件、标签和新增数写入运营操作日志，中途失败时也记录已写入的条数
+func (uc *ReviewUsecase) BulkTagReviews(ctx context.Context, filter BulkFilter, tagID int64, opUser string) (int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] BulkTagReviews filter:%+v tagID:%v opUser:%v", filter, tagID, opUser)
+	// 1. 校验标签是否存在
+	if _, err := uc.repo.GetReviewTag(ctx, tagID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, pkgerrors.NotFoundError("tag", tagID)
+		}
+		return 0, v1.ErrorDbFailed("查询数据库失败")
+	}
+	// 2. 按主键分批查出符合条件的评价，每批写入标签关联（已打过该标签的评价会被忽略）
+	total, err := uc.bulkTag(ctx, &filter, tagID)
+	// 3. 记录操作日志
+	if logErr := uc.saveBulkTagLog(ctx, &filter, tagID, opUser, total); logErr != nil {
+		uc.log.WithContext(ctx).Errorf("SaveAuditLog fail, tagID:%d filter:%+v opUser:%s inserted:%d err:%v", tagID, filter, opUser, total, logErr)
+		if err == nil {
+			err = v1.ErrorDbFailed("写入操作日志失败")
+		}
+	}
+	return total, err
+}
+
+func (uc *ReviewUsecase) bulkTag(ctx context.Context, filter *BulkFilter, tagID int64) (int64, error) {
+	var (
+		total   int64
+		afterID int64
+	)
+	for {
+		reviews, err := uc.repo.ListReviewByFilter(ctx, filter, afterID, bulkTagBatchSize)
+		if err != nil {
+			return total, v1.ErrorDbFailed("查询数据库失败")
+		}
+		if len(reviews) == 0 {
+			return total, nil
+		}
+		maps := make([]*model.ReviewTagMap, 0, len(reviews))
+		for _, review := range reviews {
+			maps = append(maps, &model.ReviewTagMap{ReviewID: review.ReviewID, TagID: tagID})
+		}
+		n, err := uc.repo.SaveReviewTagMaps(ctx, maps)
+		if err != nil {
+			return total, v1.ErrorDbFailed("写入数据库失败")
+		}
+		total += n
+		afterID = reviews[len(reviews)-1].ReviewID
+		if len(reviews) < bulkTagBatchSize {
+			return total, nil
+		}
+	}
+}
+
+func (uc *ReviewUsecase) saveBulkTagLog(ctx context.Context, filter *BulkFilter, tagID int64, opUser string, affected int64) error {
+	params, err := json.Marshal(struct {
+		*BulkFilter
+		TagID int64 `json:"tag_id"`
+	}{filter, tagID})
+	if err != nil {
+		return err
+	}
+	return uc.repo.SaveAuditLog(ctx, &model.ReviewAuditLog{
+		LogID:    snowflake.GenID(),
+		OpUser:   opUser,
+		Action:   AuditActionBulkTag,
+		Params:   string(params),
+		Affected: affected,
+	})
+}
+
+// contentHash 计算评价内容哈希，内容忽略大小写和首尾空白
+func contentHash(spuID int64, content string) string {
+	sum := sha256.Sum256([]byte(strconv.FormatInt(spuID, 10) + ":" + strings.ToLower(strings.TrimSpace(content))))
+	return hex.EncodeToString(sum[:])
+}
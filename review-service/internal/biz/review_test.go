@@ -0,0 +1,416 @@
+package biz
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/pkg/budget"
+	pkgerrors "review-service/pkg/errors"
+	"review-service/pkg/snowflake"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+func TestMain(m *testing.M) {
+	// 创建评价、撤回申请等都要生成ID
+	if err := snowflake.Init("2023-01-01", 1); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// fakeReviewRepo 内存实现的ReviewRepo，条件更新的语义与data层一致
+// 没有实现的方法调用时会因为内嵌的nil接口panic
+type fakeReviewRepo struct {
+	ReviewRepo
+
+	mu          sync.Mutex
+	reviews     map[int64]*model.ReviewInfo
+	withdrawals map[int64]*model.ReviewWithdrawalInfo
+	tags        map[int64]*model.ReviewTagInfo
+	tagMaps     map[[2]int64]struct{}
+	auditLogs   []*model.ReviewAuditLog
+	// tagMapBatches 每次SaveReviewTagMaps写入的条数
+	tagMapBatches []int
+}
+
+func newFakeReviewRepo(reviews ...*model.ReviewInfo) *fakeReviewRepo {
+	r := &fakeReviewRepo{
+		reviews:     make(map[int64]*model.ReviewInfo),
+		withdrawals: make(map[int64]*model.ReviewWithdrawalInfo),
+		tags:        make(map[int64]*model.ReviewTagInfo),
+		tagMaps:     make(map[[2]int64]struct{}),
+	}
+	for _, review := range reviews {
+		r.reviews[review.ReviewID] = review
+	}
+	return r
+}
+
+func newTestReviewUsecase(repo ReviewRepo) *ReviewUsecase {
+	queue := NewReviewPriorityQueue(newFakeModerationQueueRepo(), log.DefaultLogger)
+	return NewReviewUsecase(repo, queue, NewReviewAnomalyDetector(repo, log.DefaultLogger), &conf.Business{}, log.DefaultLogger)
+}
+
+func (r *fakeReviewRepo) GetReview(_ context.Context, reviewID int64) (*model.ReviewInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	review, ok := r.reviews[reviewID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *review
+	return &copied, nil
+}
+
+func (r *fakeReviewRepo) GetReviewByOrderID(_ context.Context, orderID int64) ([]*model.ReviewInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var list []*model.ReviewInfo
+	for _, review := range r.reviews {
+		if review.OrderID == orderID {
+			list = append(list, review)
+		}
+	}
+	return list, nil
+}
+
+func (r *fakeReviewRepo) SaveReview(_ context.Context, review *model.ReviewInfo) (*model.ReviewInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reviews[review.ReviewID] = review
+	return review, nil
+}
+
+func (r *fakeReviewRepo) AuditReview(_ context.Context, param *AuditParam) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	review, ok := r.reviews[param.ReviewID]
+	if !ok || review.Status >= ReviewStatusWithdrawalPending {
+		return ErrStatusChanged
+	}
+	review.Status = param.Status
+	review.OpUser = param.OpUser
+	review.OpReason = param.OpReason
+	return nil
+}
+
+func (r *fakeReviewRepo) GetReviewTag(_ context.Context, tagID int64) (*model.ReviewTagInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tag, ok := r.tags[tagID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return tag, nil
+}
+
+// ListReviewByFilter 按评价ID升序返回，和data层按评价ID分批一致
+func (r *fakeReviewRepo) ListReviewByFilter(_ context.Context, filter *BulkFilter, afterID int64, limit int) ([]*model.ReviewInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var list []*model.ReviewInfo
+	for _, review := range r.reviews {
+		switch {
+		case review.ReviewID <= afterID,
+			filter.StoreID > 0 && review.StoreID != filter.StoreID,
+			filter.Status > 0 && review.Status != filter.Status,
+			filter.MinScore > 0 && review.Score < filter.MinScore,
+			filter.MaxScore > 0 && review.Score > filter.MaxScore:
+			continue
+		}
+		list = append(list, review)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ReviewID < list[j].ReviewID })
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+// SaveReviewTagMaps 已存在的关联不计数，和INSERT IGNORE一致
+func (r *fakeReviewRepo) SaveReviewTagMaps(_ context.Context, maps []*model.ReviewTagMap) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tagMapBatches = append(r.tagMapBatches, len(maps))
+	var n int64
+	for _, m := range maps {
+		key := [2]int64{m.ReviewID, m.TagID}
+		if _, ok := r.tagMaps[key]; ok {
+			continue
+		}
+		r.tagMaps[key] = struct{}{}
+		n++
+	}
+	return n, nil
+}
+
+func (r *fakeReviewRepo) SaveAuditLog(_ context.Context, auditLog *model.ReviewAuditLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.auditLogs = append(r.auditLogs, auditLog)
+	return nil
+}
+
+func (r *fakeReviewRepo) GetWithdrawal(_ context.Context, withdrawalID int64) (*model.ReviewWithdrawalInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	withdrawal, ok := r.withdrawals[withdrawalID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *withdrawal
+	return &copied, nil
+}
+
+func (r *fakeReviewRepo) GetWithdrawalByReviewID(_ context.Context, reviewID int64) ([]*model.ReviewWithdrawalInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var list []*model.ReviewWithdrawalInfo
+	for _, withdrawal := range r.withdrawals {
+		if withdrawal.ReviewID == reviewID {
+			copied := *withdrawal
+			list = append(list, &copied)
+		}
+	}
+	return list, nil
+}
+
+func (r *fakeReviewRepo) ListAllReviewByUserID(_ context.Context, userID int64) ([]*model.ReviewInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var list []*model.ReviewInfo
+	for _, review := range r.reviews {
+		if review.UserID == userID {
+			copied := *review
+			list = append(list, &copied)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ReviewID > list[j].ReviewID })
+	return list, nil
+}
+
+func (r *fakeReviewRepo) ListWithdrawalByUserID(_ context.Context, userID int64) ([]*model.ReviewWithdrawalInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var list []*model.ReviewWithdrawalInfo
+	for _, withdrawal := range r.withdrawals {
+		if withdrawal.UserID == userID {
+			copied := *withdrawal
+			list = append(list, &copied)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].WithdrawalID < list[j].WithdrawalID })
+	return list, nil
+}
+
+func (r *fakeReviewRepo) SaveWithdrawal(_ context.Context, withdrawal *model.ReviewWithdrawalInfo) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	review, ok := r.reviews[withdrawal.ReviewID]
+	if !ok || review.Status != ReviewStatusApproved {
+		return ErrStatusChanged
+	}
+	review.Status = ReviewStatusWithdrawalPending
+	copied := *withdrawal
+	r.withdrawals[withdrawal.WithdrawalID] = &copied
+	return nil
+}
+
+func (r *fakeReviewRepo) AuditWithdrawal(_ context.Context, withdrawal *model.ReviewWithdrawalInfo, reviewStatus int32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.withdrawals[withdrawal.WithdrawalID]
+	if !ok || stored.Status != WithdrawalStatusPending {
+		return ErrStatusChanged
+	}
+	stored.Status = withdrawal.Status
+	stored.OpUser = withdrawal.OpUser
+	stored.OpRemarks = withdrawal.OpRemarks
+	if review, ok := r.reviews[stored.ReviewID]; ok && review.Status == ReviewStatusWithdrawalPending {
+		review.Status = reviewStatus
+	}
+	return nil
+}
+
+func TestBulkTagReviews(t *testing.T) {
+	const tagID = 7
+	// 1200条评价，前1000条属于店铺1，评分1~5循环
+	seed := func() *fakeReviewRepo {
+		var reviews []*model.ReviewInfo
+		for i := int64(1); i <= 1200; i++ {
+			storeID := int64(1)
+			if i > 1000 {
+				storeID = 2
+			}
+			reviews = append(reviews, &model.ReviewInfo{ID: i, ReviewID: 10000 + i, StoreID: storeID, Score: int32(i%5 + 1), Status: ReviewStatusApproved})
+		}
+		repo := newFakeReviewRepo(reviews...)
+		repo.tags[tagID] = &model.ReviewTagInfo{TagID: tagID}
+		return repo
+	}
+	tests := []struct {
+		name        string
+		filter      BulkFilter
+		preTagged   int64
+		wantCount   int64
+		wantBatches []int
+		wantParams  string
+	}{
+		{
+			name:        "store filter matches 1000",
+			filter:      BulkFilter{StoreID: 1},
+			wantCount:   1000,
+			wantBatches: []int{500, 500},
+			wantParams:  `{"store_id":1,"tag_id":7}`,
+		},
+		{
+			name:        "already tagged reviews are skipped",
+			filter:      BulkFilter{StoreID: 1},
+			preTagged:   100,
+			wantCount:   900,
+			wantBatches: []int{500, 500},
+			wantParams:  `{"store_id":1,"tag_id":7}`,
+		},
+		{
+			name:        "store and score filter",
+			filter:      BulkFilter{StoreID: 1, MinScore: 4},
+			wantCount:   400,
+			wantBatches: []int{400},
+			wantParams:  `{"store_id":1,"min_score":4,"tag_id":7}`,
+		},
+		{
+			name:        "no filter",
+			wantCount:   1200,
+			wantBatches: []int{500, 500, 200},
+			wantParams:  `{"tag_id":7}`,
+		},
+		{
+			name:       "no match",
+			filter:     BulkFilter{StoreID: 3},
+			wantParams: `{"store_id":3,"tag_id":7}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := seed()
+			for i := int64(1); i <= tt.preTagged; i++ {
+				repo.tagMaps[[2]int64{10000 + i, tagID}] = struct{}{}
+			}
+			uc := newTestReviewUsecase(repo)
+			count, err := uc.BulkTagReviews(context.Background(), tt.filter, tagID, "curator")
+			if err != nil {
+				t.Fatalf("BulkTagReviews err: %v", err)
+			}
+			if count != tt.wantCount {
+				t.Fatalf("count = %d, want %d", count, tt.wantCount)
+			}
+			if !reflect.DeepEqual(repo.tagMapBatches, tt.wantBatches) {
+				t.Fatalf("tag map batches = %v, want %v", repo.tagMapBatches, tt.wantBatches)
+			}
+			if len(repo.auditLogs) != 1 {
+				t.Fatalf("audit logs = %d, want 1", len(repo.auditLogs))
+			}
+			got := repo.auditLogs[0]
+			if got.OpUser != "curator" || got.Action != AuditActionBulkTag || got.Affected != tt.wantCount || got.Params != tt.wantParams || got.LogID == 0 {
+				t.Fatalf("audit log = %+v, want opUser curator, action %s, affected %d, params %s", got, AuditActionBulkTag, tt.wantCount, tt.wantParams)
+			}
+		})
+	}
+}
+
+func TestBulkTagReviewsTagNotFound(t *testing.T) {
+	repo := newFakeReviewRepo(&model.ReviewInfo{ID: 1, ReviewID: 1, StoreID: 1})
+	uc := newTestReviewUsecase(repo)
+	if _, err := uc.BulkTagReviews(context.Background(), BulkFilter{StoreID: 1}, 99, "curator"); !pkgerrors.IsNotFoundError(err) {
+		t.Fatalf("err = %v, want RESOURCE_NOT_FOUND", err)
+	}
+	if len(repo.tagMapBatches) != 0 || len(repo.auditLogs) != 0 {
+		t.Fatalf("nothing should be written for a missing tag, batches:%v logs:%d", repo.tagMapBatches, len(repo.auditLogs))
+	}
+}
+
+// blockingModerationQueueRepo 入队一直阻塞到ctx取消，模拟Redis没有响应
+type blockingModerationQueueRepo struct {
+	*fakeModerationQueueRepo
+}
+
+func (r blockingModerationQueueRepo) PushModeration(ctx context.Context, _, _ int64, _ float64) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// TestCreateReviewEnqueueWithinBudget 待审核队列入队计入耗时预算，Redis没有响应时评价仍然创建成功
+func TestCreateReviewEnqueueWithinBudget(t *testing.T) {
+	repo := newFakeReviewRepo()
+	queue := NewReviewPriorityQueue(blockingModerationQueueRepo{newFakeModerationQueueRepo()}, log.DefaultLogger)
+	uc := NewReviewUsecase(repo, queue, NewReviewAnomalyDetector(repo, log.DefaultLogger), &conf.Business{}, log.DefaultLogger)
+
+	ctx := budget.NewBudgetContext(context.Background(), 50*time.Millisecond)
+	start := time.Now()
+	review, err := uc.CreateReview(ctx, &model.ReviewInfo{OrderID: 1, UserID: 2, StoreID: 3, SpuID: 4, SkuID: 5, Score: 5, Content: "质量很好"})
+	if err != nil {
+		t.Fatalf("CreateReview fail, err:%v", err)
+	}
+	if cost := time.Since(start); cost > 500*time.Millisecond {
+		t.Fatalf("CreateReview returned after %v, want the enqueue cancelled at the budget", cost)
+	}
+	if _, err := repo.GetReview(ctx, review.ReviewID); err != nil {
+		t.Fatalf("review not saved, err:%v", err)
+	}
+	if remaining, _ := budget.Remaining(ctx); remaining != 0 {
+		t.Fatalf("remaining budget = %v, want 0", remaining)
+	}
+}
+
+// pausingReviewRepo 查询订单时通知测试并等待放行，用来在请求处理中途替换配置
+type pausingReviewRepo struct {
+	*fakeReviewRepo
+	paused chan struct{}
+	resume chan struct{}
+}
+
+func (r *pausingReviewRepo) GetReviewByOrderID(ctx context.Context, orderID int64) ([]*model.ReviewInfo, error) {
+	close(r.paused)
+	<-r.resume
+	return r.fakeReviewRepo.GetReviewByOrderID(ctx, orderID)
+}
+
+// TestCreateReviewKeepsConfigDuringReload 处理中的请求使用开始时的配置，新配置只影响之后的请求
+func TestCreateReviewKeepsConfigDuringReload(t *testing.T) {
+	repo := &pausingReviewRepo{fakeReviewRepo: newFakeReviewRepo(), paused: make(chan struct{}), resume: make(chan struct{})}
+	uc := NewReviewUsecase(repo, NewReviewPriorityQueue(newFakeModerationQueueRepo(), log.DefaultLogger), NewReviewAnomalyDetector(repo, log.DefaultLogger), &conf.Business{}, log.DefaultLogger)
+
+	type result struct {
+		review *model.ReviewInfo
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		review, err := uc.CreateReview(context.Background(), &model.ReviewInfo{OrderID: 1, UserID: 2, StoreID: 3, SpuID: 4, Content: "好评"})
+		done <- result{review, err}
+	}()
+	<-repo.paused
+	uc.Reload(&conf.Business{AllowDuplicateContent: true, MinReviewLength: 10})
+	close(repo.resume)
+
+	res := <-done
+	if res.err != nil {
+		t.Fatalf("in-flight CreateReview fail, err:%v", res.err)
+	}
+	if res.review.ContentHash == nil {
+		t.Fatal("in-flight CreateReview used the reloaded allow_duplicate_content, want content hash from the old config")
+	}
+	if _, err := uc.CreateReview(context.Background(), &model.ReviewInfo{OrderID: 2, Content: "好评"}); !v1.IsContentTooShort(err) {
+		t.Fatalf("CreateReview after reload err = %v, want CONTENT_TOO_SHORT", err)
+	}
+}
@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 按小时汇总各店铺的评价创建数，写入review_creation_rollup，画趋势图时直接查汇总表
+
+// RollupPoint 趋势图上的一个点
+type RollupPoint struct {
+	Hour  time.Time
+	Count int64
+}
+
+// StoreReviewCount 某个店铺在统计区间内创建的评价数
+type StoreReviewCount struct {
+	StoreID     int64
+	ReviewCount int64
+}
+
+type RollupRepo interface {
+	// CountReviewByStore 统计[from, to)内每个店铺创建的评价数
+	CountReviewByStore(ctx context.Context, from, to time.Time) ([]*StoreReviewCount, error)
+	// SaveCreationRollups 写入汇总结果，同一店铺同一小时重复写入时覆盖
+	SaveCreationRollups(context.Context, []*model.ReviewCreationRollup) error
+	ListCreationRollup(ctx context.Context, storeID int64, from, to time.Time) ([]*model.ReviewCreationRollup, error)
+}
+
+type RollupUsecase struct {
+	repo RollupRepo
+	log  *log.Helper
+}
+
+func NewRollupUsecase(repo RollupRepo, logger log.Logger) *RollupUsecase {
+	return &RollupUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// RollupHour 汇总hour所在整点小时内的评价创建数
+func (uc *RollupUsecase) RollupHour(ctx context.Context, hour time.Time) error {
+	from := hour.Truncate(time.Hour)
+	to := from.Add(time.Hour)
+	counts, err := uc.repo.CountReviewByStore(ctx, from, to)
+	if err != nil {
+		return v1.ErrorDbFailed("查询数据库失败")
+	}
+	if len(counts) == 0 {
+		return nil
+	}
+	rollups := make([]*model.ReviewCreationRollup, 0, len(counts))
+	for _, c := range counts {
+		rollups = append(rollups, &model.ReviewCreationRollup{
+			Hour:        from,
+			StoreID:     c.StoreID,
+			ReviewCount: c.ReviewCount,
+		})
+	}
+	if err := uc.repo.SaveCreationRollups(ctx, rollups); err != nil {
+		return v1.ErrorDbFailed("写入数据库失败")
+	}
+	uc.log.WithContext(ctx).Infof("[biz] RollupHour done, hour:%v stores:%d", from, len(rollups))
+	return nil
+}
+
+// GetCreationTrend 查询店铺在[from, to)内每小时的评价创建数，没有评价的小时不返回
+func (uc *RollupUsecase) GetCreationTrend(ctx context.Context, storeID int64, from, to time.Time) ([]*RollupPoint, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetCreationTrend storeID:%v from:%v to:%v", storeID, from, to)
+	rollups, err := uc.repo.ListCreationRollup(ctx, storeID, from, to)
+	if err != nil {
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+	points := make([]*RollupPoint, 0, len(rollups))
+	for _, r := range rollups {
+		points = append(points, &RollupPoint{Hour: r.Hour, Count: r.ReviewCount})
+	}
+	return points, nil
+}
+
+// RollupJob 每个整点汇总上一个小时的数据，实现了transport.Server，随kratos.App启停
+type RollupJob struct {
+	uc   *RollupUsecase
+	log  *log.Helper
+	stop chan struct{}
+}
+
+func NewRollupJob(uc *RollupUsecase, logger log.Logger) *RollupJob {
+	return &RollupJob{
+		uc:   uc,
+		log:  log.NewHelper(logger),
+		stop: make(chan struct{}),
+	}
+}
+
+// Start 阻塞运行直到Stop被调用或ctx取消
+func (j *RollupJob) Start(ctx context.Context) error {
+	for {
+		next := time.Now().Truncate(time.Hour).Add(time.Hour)
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-j.stop:
+			timer.Stop()
+			return nil
+		case <-timer.C:
+			if err := j.uc.RollupHour(ctx, next.Add(-time.Hour)); err != nil {
+				j.log.Errorf("RollupJob rollup hour:%v fail, err:%v", next.Add(-time.Hour), err)
+			}
+		}
+	}
+}
+
+func (j *RollupJob) Stop(context.Context) error {
+	close(j.stop)
+	return nil
+}
@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"review-service/pkg/statistics"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 商品评价情感突变告警：每天用Z检验比较商品最近7天与之前30天的日均评分（评分作为情感得分）
+// 评分在99%置信度下显著下降时发送高优先级告警，可能是商品出了质量问题；95%置信度下显著下降时发送普通告警
+
+const (
+	sentimentRecentDays   = 7
+	sentimentBaselineDays = 30
+	// sentimentCheckInterval 检测间隔
+	sentimentCheckInterval = 24 * time.Hour
+)
+
+// AlertPriority 告警优先级
+type AlertPriority int
+
+const (
+	AlertPriorityNormal AlertPriority = iota
+	// AlertPriorityHigh 需要立即处理，通知所有值班人员
+	AlertPriorityHigh
+)
+
+// Alert 发送给运营的告警
+type Alert struct {
+	Priority AlertPriority
+	Title    string
+	Text     string
+}
+
+// AlertNotifier 发送告警
+type AlertNotifier interface {
+	Notify(ctx context.Context, alert *Alert) error
+}
+
+type SentimentRepo interface {
+	// ListDailySentiment 每个商品在[from, to)内每天审核通过的评价的平均评分，没有评价的天不返回
+	ListDailySentiment(ctx context.Context, from, to time.Time) (map[int64][]float64, error)
+}
+
+// SentimentShift 商品评分的变化
+type SentimentShift struct {
+	SpuID        int64
+	RecentMean   float64
+	BaselineMean float64
+	Z            float64
+}
+
+// SentimentAlertJob 每天检测一次商品评分的突变，实现了transport.Server，随kratos.App启停
+type SentimentAlertJob struct {
+	repo     SentimentRepo
+	notifier AlertNotifier
+	log      *log.Helper
+	stop     chan struct{}
+}
+
+func NewSentimentAlertJob(repo SentimentRepo, notifier AlertNotifier, logger log.Logger) *SentimentAlertJob {
+	return &SentimentAlertJob{
+		repo:     repo,
+		notifier: notifier,
+		log:      log.NewHelper(logger),
+		stop:     make(chan struct{}),
+	}
+}
+
+// Start 阻塞运行直到Stop被调用或ctx取消
+func (j *SentimentAlertJob) Start(ctx context.Context) error {
+	ticker := time.NewTicker(sentimentCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-j.stop:
+			return nil
+		case now := <-ticker.C:
+			if _, err := j.CheckSentiment(ctx, now); err != nil {
+				j.log.Errorf("SentimentAlertJob check fail, err:%v", err)
+			}
+		}
+	}
+}
+
+func (j *SentimentAlertJob) Stop(context.Context) error {
+	close(j.stop)
+	return nil
+}
+
+// CheckSentiment 以now所在的自然日为界，比较之前7天和再之前30天的日均评分，对显著下降的商品发送告警
+// 返回显著下降的商品，按Z值从小到大排列
+func (j *SentimentAlertJob) CheckSentiment(ctx context.Context, now time.Time) ([]*SentimentShift, error) {
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	recentFrom := end.AddDate(0, 0, -sentimentRecentDays)
+	baselineFrom := recentFrom.AddDate(0, 0, -sentimentBaselineDays)
+	recent, err := j.repo.ListDailySentiment(ctx, recentFrom, end)
+	if err != nil {
+		return nil, err
+	}
+	baseline, err := j.repo.ListDailySentiment(ctx, baselineFrom, recentFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	var shifts []*SentimentShift
+	for spuID, days := range recent {
+		z, significant := statistics.ZTestSentimentAlert(days, baseline[spuID])
+		// 只关心评分下降
+		if z > -statistics.CriticalZ95 {
+			continue
+		}
+		shift := &SentimentShift{
+			SpuID:        spuID,
+			RecentMean:   statistics.Mean(days),
+			BaselineMean: statistics.Mean(baseline[spuID]),
+			Z:            z,
+		}
+		shifts = append(shifts, shift)
+		alert := &Alert{
+			Priority: AlertPriorityNormal,
+			Title:    fmt.Sprintf("商品%d评分下降", spuID),
+			Text: fmt.Sprintf("商品%d最近%d天日均评分%.2f，之前%d天%.2f，z=%.2f",
+				spuID, sentimentRecentDays, shift.RecentMean, sentimentBaselineDays, shift.BaselineMean, z),
+		}
+		if significant {
+			alert.Priority = AlertPriorityHigh
+			alert.Title = fmt.Sprintf("商品%d评分显著下降，可能存在质量问题", spuID)
+		}
+		if err := j.notifier.Notify(ctx, alert); err != nil {
+			j.log.WithContext(ctx).Errorf("SentimentAlertJob notify fail, spuID:%v err:%v", spuID, err)
+		}
+	}
+	sort.Slice(shifts, func(a, b int) bool { return shifts[a].Z < shifts[b].Z })
+	return shifts, nil
+}
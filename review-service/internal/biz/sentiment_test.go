@@ -0,0 +1,97 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeSentimentRepo 按查询区间的起点返回最近7天或之前30天的日均评分
+type fakeSentimentRepo struct {
+	recentFrom time.Time
+	recent     map[int64][]float64
+	baseline   map[int64][]float64
+}
+
+func (r *fakeSentimentRepo) ListDailySentiment(_ context.Context, from, to time.Time) (map[int64][]float64, error) {
+	if from.Equal(r.recentFrom) {
+		return r.recent, nil
+	}
+	if to.Equal(r.recentFrom) {
+		return r.baseline, nil
+	}
+	return nil, nil
+}
+
+type fakeAlertNotifier struct {
+	mu     sync.Mutex
+	alerts []*Alert
+}
+
+func (n *fakeAlertNotifier) Notify(_ context.Context, alert *Alert) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+func repeat(score float64, days int) []float64 {
+	xs := make([]float64, days)
+	for i := range xs {
+		xs[i] = score
+	}
+	return xs
+}
+
+func TestSentimentAlertJobCheckSentiment(t *testing.T) {
+	// 之前30天每天的均分在4.0和5.0之间交替
+	baseline := make([]float64, 30)
+	for i := range baseline {
+		baseline[i] = 4.0 + float64(i%2)
+	}
+	tests := []struct {
+		name         string
+		recent       []float64
+		baseline     []float64
+		wantAlert    bool
+		wantPriority AlertPriority
+	}{
+		{"significant drop", repeat(3.5, 7), baseline, true, AlertPriorityHigh},
+		{"drop at 95%", repeat(4.0, 7), baseline, true, AlertPriorityNormal},
+		{"small drop", repeat(4.3, 7), baseline, false, 0},
+		{"significant rise", repeat(5.0, 7), baseline, false, 0},
+		{"new product", repeat(1.0, 7), nil, false, 0},
+	}
+	now := time.Date(2026, 3, 10, 15, 30, 0, 0, time.Local)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeSentimentRepo{
+				// 最近7天从3月3日0点开始，到3月10日0点结束，不包含当天
+				recentFrom: time.Date(2026, 3, 3, 0, 0, 0, 0, time.Local),
+				recent:     map[int64][]float64{1: tt.recent},
+				baseline:   map[int64][]float64{1: tt.baseline},
+			}
+			notifier := &fakeAlertNotifier{}
+			job := NewSentimentAlertJob(repo, notifier, log.DefaultLogger)
+			shifts, err := job.CheckSentiment(context.Background(), now)
+			if err != nil {
+				t.Fatalf("CheckSentiment fail, err:%v", err)
+			}
+			if !tt.wantAlert {
+				if len(shifts) != 0 || len(notifier.alerts) != 0 {
+					t.Fatalf("shifts = %v alerts = %v, want none", shifts, notifier.alerts)
+				}
+				return
+			}
+			if len(shifts) != 1 || shifts[0].SpuID != 1 || shifts[0].Z >= 0 {
+				t.Fatalf("shifts = %+v, want a drop of spu 1", shifts)
+			}
+			if len(notifier.alerts) != 1 || notifier.alerts[0].Priority != tt.wantPriority {
+				t.Fatalf("alerts = %+v, want one alert with priority %v", notifier.alerts, tt.wantPriority)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 分步骤创建评价（评分 → 文字 → 图片）的服务端会话
+// 每一步的数据合并到PartialData中暂存在Redis，最后一步提交时走完整的CreateReview流程
+
+const (
+	// ReviewSessionTTL 会话有效期，每次更新都会续期
+	ReviewSessionTTL = 30 * time.Minute
+	// reviewSessionSteps 评价流程的总步数
+	reviewSessionSteps = 3
+)
+
+// ReviewSession 评价会话
+type ReviewSession struct {
+	SessionID string `json:"session_id"`
+	UserID    int64  `json:"user_id"`
+	OrderID   int64  `json:"order_id"`
+	StoreID   int64  `json:"store_id"`
+	SpuID     int64  `json:"spu_id"`
+	SkuID     int64  `json:"sku_id"`
+	Step      int    `json:"step"`
+	// CompletedSteps 已经提交过数据的步骤，三步都提交后才能提交会话
+	CompletedSteps []int                  `json:"completed_steps"`
+	PartialData    map[string]interface{} `json:"partial_data"`
+	ExpiresAt      time.Time              `json:"expires_at"`
+}
+
+// StartSessionParam 开始评价会话的参数，订单和商品信息在开始时确定，后续步骤不能修改
+type StartSessionParam struct {
+	UserID  int64
+	OrderID int64
+	StoreID int64
+	SpuID   int64
+	SkuID   int64
+}
+
+// reviewSessionData 会话中暂存的评价内容，字段名与CreateReviewRequest保持一致
+type reviewSessionData struct {
+	Score        int32  `json:"score"`
+	ServiceScore int32  `json:"serviceScore"`
+	ExpressScore int32  `json:"expressScore"`
+	Content      string `json:"content"`
+	PicInfo      string `json:"picInfo"`
+	VideoInfo    string `json:"videoInfo"`
+	Anonymous    bool   `json:"anonymous"`
+}
+
+// ReviewSessionRepo 会话存储，会话不存在或已过期时GetReviewSession返回(nil, nil)
+type ReviewSessionRepo interface {
+	SaveReviewSession(ctx context.Context, session *ReviewSession, ttl time.Duration) error
+	GetReviewSession(ctx context.Context, sessionID string) (*ReviewSession, error)
+	// UpdateReviewSession 原子地读取会话、用fn修改后保存并续期ttl，fn返回错误时不保存并返回该错误
+	// 会话不存在或已过期时fn的参数为nil；并发修改冲突时会重新读取会话再次调用fn
+	UpdateReviewSession(ctx context.Context, sessionID string, ttl time.Duration, fn func(session *ReviewSession) error) error
+	DeleteReviewSession(ctx context.Context, sessionID string) error
+	// ListReviewSessionByUserID 查询用户所有未过期的会话
+	ListReviewSessionByUserID(ctx context.Context, userID int64) ([]*ReviewSession, error)
+}
+
+type ReviewSessionUsecase struct {
+	repo   ReviewSessionRepo
+	review *ReviewUsecase
+	log    *log.Helper
+}
+
+func NewReviewSessionUsecase(repo ReviewSessionRepo, review *ReviewUsecase, logger log.Logger) *ReviewSessionUsecase {
+	return &ReviewSessionUsecase{
+		repo:   repo,
+		review: review,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// StartReviewSession 开始一个评价会话，返回会话ID
+func (uc *ReviewSessionUsecase) StartReviewSession(ctx context.Context, param *StartSessionParam) (string, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] StartReviewSession param:%+v", param)
+	sessionID, err := newSessionID()
+	if err != nil {
+		return "", err
+	}
+	session := &ReviewSession{
+		SessionID:   sessionID,
+		UserID:      param.UserID,
+		OrderID:     param.OrderID,
+		StoreID:     param.StoreID,
+		SpuID:       param.SpuID,
+		SkuID:       param.SkuID,
+		PartialData: map[string]interface{}{},
+		ExpiresAt:   time.Now().Add(ReviewSessionTTL),
+	}
+	if err := uc.repo.SaveReviewSession(ctx, session, ReviewSessionTTL); err != nil {
+		return "", v1.ErrorDbFailed("保存评价会话失败")
+	}
+	return sessionID, nil
+}
+
+// UpdateReviewSession 保存userID的会话某一步填写的数据并续期
+// 步骤按顺序填写，可以修改已经填写过的步骤，不能跳过还没填写的步骤
+func (uc *ReviewSessionUsecase) UpdateReviewSession(ctx context.Context, sessionID string, userID int64, step int, data map[string]interface{}) error {
+	uc.log.WithContext(ctx).Debugf("[biz] UpdateReviewSession sessionID:%v userID:%v step:%v", sessionID, userID, step)
+	if step < 1 || step > reviewSessionSteps {
+		return errors.BadRequest("INVALID_STEP", "step取值范围为[1, 3]")
+	}
+	// fn返回的业务错误原样返回，其余错误都是存储失败
+	var updateErr error
+	err := uc.repo.UpdateReviewSession(ctx, sessionID, ReviewSessionTTL, func(session *ReviewSession) error {
+		if updateErr = checkSessionOwner(session, sessionID, userID); updateErr != nil {
+			return updateErr
+		}
+		if step > session.Step+1 {
+			updateErr = errors.BadRequest("STEP_OUT_OF_ORDER", fmt.Sprintf("请先完成第%d步", session.Step+1))
+			return updateErr
+		}
+		for k, v := range data {
+			session.PartialData[k] = v
+		}
+		if step > session.Step {
+			session.Step = step
+		}
+		if !containsStep(session.CompletedSteps, step) {
+			session.CompletedSteps = append(session.CompletedSteps, step)
+		}
+		session.ExpiresAt = time.Now().Add(ReviewSessionTTL)
+		return nil
+	})
+	if updateErr != nil {
+		return updateErr
+	}
+	if err != nil {
+		return v1.ErrorDbFailed("保存评价会话失败")
+	}
+	return nil
+}
+
+// CommitReviewSession 提交userID的会话，用暂存的数据创建评价，成功后删除会话
+// timezoneOffset是提交时客户端上报的时区，和CreateReview一样记录到评价上
+func (uc *ReviewSessionUsecase) CommitReviewSession(ctx context.Context, sessionID string, userID int64, timezoneOffset int32) (*model.ReviewInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CommitReviewSession sessionID:%v userID:%v", sessionID, userID)
+	session, err := uc.repo.GetReviewSession(ctx, sessionID)
+	if err != nil {
+		return nil, v1.ErrorDbFailed("查询评价会话失败")
+	}
+	if err := checkSessionOwner(session, sessionID, userID); err != nil {
+		return nil, err
+	}
+	if len(session.CompletedSteps) < reviewSessionSteps {
+		return nil, errors.BadRequest("SESSION_INCOMPLETE", "评价会话还有未完成的步骤")
+	}
+	b, err := json.Marshal(session.PartialData)
+	if err != nil {
+		return nil, err
+	}
+	var data reviewSessionData
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, errors.BadRequest("INVALID_SESSION_DATA", "评价会话数据格式错误")
+	}
+	// 会话数据不经过接口的参数校验，提交前按CreateReviewRequest的规则校验
+	req := &v1.CreateReviewRequest{
+		UserID:       session.UserID,
+		OrderID:      session.OrderID,
+		StoreID:      session.StoreID,
+		SpuID:        session.SpuID,
+		SkuID:        session.SkuID,
+		Score:        data.Score,
+		ServiceScore: data.ServiceScore,
+		ExpressScore: data.ExpressScore,
+		Content:      data.Content,
+		PicInfo:      data.PicInfo,
+		VideoInfo:    data.VideoInfo,
+		Anonymous:    data.Anonymous,
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errors.BadRequest("INVALID_SESSION_DATA", err.Error())
+	}
+	var anonymous int32
+	if data.Anonymous {
+		anonymous = 1
+	}
+	review, err := uc.review.CreateReview(ctx, &model.ReviewInfo{
+		UserID:         session.UserID,
+		OrderID:        session.OrderID,
+		StoreID:        session.StoreID,
+		SpuID:          session.SpuID,
+		SkuID:          session.SkuID,
+		Score:          data.Score,
+		ServiceScore:   data.ServiceScore,
+		ExpressScore:   data.ExpressScore,
+		Content:        data.Content,
+		PicInfo:        data.PicInfo,
+		VideoInfo:      data.VideoInfo,
+		Anonymous:      anonymous,
+		TimezoneOffset: timezoneOffset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 评价已创建，删除失败只打日志，会话会自然过期
+	if err := uc.repo.DeleteReviewSession(ctx, sessionID); err != nil {
+		uc.log.WithContext(ctx).Errorf("CommitReviewSession delete session fail, err:%v", err)
+	}
+	return review, nil
+}
+
+// checkSessionOwner 会话必须属于userID，其他用户的会话按不存在处理
+// userID由userAuth中间件校验过是当前登录用户
+func checkSessionOwner(session *ReviewSession, sessionID string, userID int64) error {
+	if session == nil || session.UserID != userID {
+		return v1.ErrorReviewSessionNotFound("评价会话:%s不存在或已过期", sessionID)
+	}
+	if session.PartialData == nil {
+		session.PartialData = map[string]interface{}{}
+	}
+	return nil
+}
+
+func containsStep(steps []int, step int) bool {
+	for _, s := range steps {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
+
+func newSessionID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
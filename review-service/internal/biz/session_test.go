@@ -0,0 +1,212 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "review-service/api/review/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeReviewSessionRepo 内存实现的会话存储，过期时间按ttl计算
+type fakeReviewSessionRepo struct {
+	mu       sync.Mutex
+	sessions map[string]ReviewSession
+	expires  map[string]time.Time
+	now      func() time.Time
+}
+
+func newFakeReviewSessionRepo() *fakeReviewSessionRepo {
+	return &fakeReviewSessionRepo{
+		sessions: make(map[string]ReviewSession),
+		expires:  make(map[string]time.Time),
+		now:      time.Now,
+	}
+}
+
+func (r *fakeReviewSessionRepo) SaveReviewSession(_ context.Context, session *ReviewSession, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions[session.SessionID] = *session
+	r.expires[session.SessionID] = r.now().Add(ttl)
+	return nil
+}
+
+func (r *fakeReviewSessionRepo) get(sessionID string) *ReviewSession {
+	session, ok := r.sessions[sessionID]
+	if !ok || !r.now().Before(r.expires[sessionID]) {
+		return nil
+	}
+	return &session
+}
+
+func (r *fakeReviewSessionRepo) GetReviewSession(_ context.Context, sessionID string) (*ReviewSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.get(sessionID), nil
+}
+
+func (r *fakeReviewSessionRepo) UpdateReviewSession(_ context.Context, sessionID string, ttl time.Duration, fn func(session *ReviewSession) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	session := r.get(sessionID)
+	if err := fn(session); err != nil {
+		return err
+	}
+	r.sessions[sessionID] = *session
+	r.expires[sessionID] = r.now().Add(ttl)
+	return nil
+}
+
+func (r *fakeReviewSessionRepo) DeleteReviewSession(_ context.Context, sessionID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.sessions, sessionID)
+	return nil
+}
+
+func (r *fakeReviewSessionRepo) ListReviewSessionByUserID(_ context.Context, userID int64) ([]*ReviewSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var list []*ReviewSession
+	for sessionID := range r.sessions {
+		if session := r.get(sessionID); session != nil && session.UserID == userID {
+			list = append(list, session)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].SessionID < list[j].SessionID })
+	return list, nil
+}
+
+func newTestSessionUsecase(t *testing.T) (*ReviewSessionUsecase, *fakeReviewSessionRepo, *fakeReviewRepo, string) {
+	t.Helper()
+	sessions := newFakeReviewSessionRepo()
+	reviews := newFakeReviewRepo()
+	uc := NewReviewSessionUsecase(sessions, newTestReviewUsecase(reviews), log.DefaultLogger)
+	sessionID, err := uc.StartReviewSession(context.Background(), &StartSessionParam{UserID: 1, OrderID: 2, StoreID: 3, SpuID: 4, SkuID: 5})
+	if err != nil {
+		t.Fatalf("StartReviewSession fail, err:%v", err)
+	}
+	return uc, sessions, reviews, sessionID
+}
+
+// completeSteps 按顺序填写三步
+func completeSteps(t *testing.T, uc *ReviewSessionUsecase, sessionID string) {
+	t.Helper()
+	steps := []map[string]interface{}{
+		{"score": 5, "serviceScore": 4, "expressScore": 3},
+		{"content": "物流很快，质量也很好"},
+		{"picInfo": "a.jpg"},
+	}
+	for i, data := range steps {
+		if err := uc.UpdateReviewSession(context.Background(), sessionID, 1, i+1, data); err != nil {
+			t.Fatalf("step %d fail, err:%v", i+1, err)
+		}
+	}
+}
+
+func TestUpdateReviewSessionStepOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		steps      []int
+		wantReason string
+	}{
+		{"in order", []int{1, 2, 3}, ""},
+		{"revisit a filled step", []int{1, 2, 1, 3}, ""},
+		{"skip step 2", []int{1, 3}, "STEP_OUT_OF_ORDER"},
+		{"start at step 2", []int{2}, "STEP_OUT_OF_ORDER"},
+		{"step out of range", []int{4}, "INVALID_STEP"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, sessions, _, sessionID := newTestSessionUsecase(t)
+			var err error
+			for _, step := range tt.steps {
+				if err = uc.UpdateReviewSession(context.Background(), sessionID, 1, step, map[string]interface{}{"content": "x"}); err != nil {
+					break
+				}
+			}
+			if got := errors.Reason(err); got != tt.wantReason {
+				t.Fatalf("reason = %q, want %q (err:%v)", got, tt.wantReason, err)
+			}
+			if tt.wantReason != "" {
+				// 被拒绝的步骤不保存
+				session, _ := sessions.GetReviewSession(context.Background(), sessionID)
+				if containsStep(session.CompletedSteps, tt.steps[len(tt.steps)-1]) {
+					t.Fatalf("rejected step saved, completed steps:%v", session.CompletedSteps)
+				}
+			}
+		})
+	}
+}
+
+func TestReviewSessionOwnerMismatch(t *testing.T) {
+	uc, _, _, sessionID := newTestSessionUsecase(t)
+	completeSteps(t, uc, sessionID)
+	ctx := context.Background()
+
+	err := uc.UpdateReviewSession(ctx, sessionID, 2, 1, map[string]interface{}{"score": 1})
+	if !v1.IsReviewSessionNotFound(err) {
+		t.Fatalf("UpdateReviewSession by another user err = %v, want REVIEW_SESSION_NOT_FOUND", err)
+	}
+	if _, err := uc.CommitReviewSession(ctx, sessionID, 2, 0); !v1.IsReviewSessionNotFound(err) {
+		t.Fatalf("CommitReviewSession by another user err = %v, want REVIEW_SESSION_NOT_FOUND", err)
+	}
+}
+
+func TestCommitReviewSession(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("incomplete", func(t *testing.T) {
+		uc, _, _, sessionID := newTestSessionUsecase(t)
+		if err := uc.UpdateReviewSession(ctx, sessionID, 1, 1, map[string]interface{}{"score": 5}); err != nil {
+			t.Fatalf("step 1 fail, err:%v", err)
+		}
+		if _, err := uc.CommitReviewSession(ctx, sessionID, 1, 0); errors.Reason(err) != "SESSION_INCOMPLETE" {
+			t.Fatalf("err = %v, want SESSION_INCOMPLETE", err)
+		}
+	})
+
+	t.Run("creates the review", func(t *testing.T) {
+		uc, sessions, reviews, sessionID := newTestSessionUsecase(t)
+		completeSteps(t, uc, sessionID)
+		review, err := uc.CommitReviewSession(ctx, sessionID, 1, 480)
+		if err != nil {
+			t.Fatalf("CommitReviewSession fail, err:%v", err)
+		}
+		saved, err := reviews.GetReview(ctx, review.ReviewID)
+		if err != nil {
+			t.Fatalf("review not saved, err:%v", err)
+		}
+		want := struct {
+			UserID, OrderID, StoreID, SpuID, SkuID int64
+			Score, ServiceScore, ExpressScore      int32
+			Content, PicInfo                       string
+			TimezoneOffset                         int32
+		}{1, 2, 3, 4, 5, 5, 4, 3, "物流很快，质量也很好", "a.jpg", 480}
+		got := want
+		got.UserID, got.OrderID, got.StoreID, got.SpuID, got.SkuID = saved.UserID, saved.OrderID, saved.StoreID, saved.SpuID, saved.SkuID
+		got.Score, got.ServiceScore, got.ExpressScore = saved.Score, saved.ServiceScore, saved.ExpressScore
+		got.Content, got.PicInfo, got.TimezoneOffset = saved.Content, saved.PicInfo, saved.TimezoneOffset
+		if got != want {
+			t.Fatalf("saved review = %+v, want %+v", got, want)
+		}
+		if session, _ := sessions.GetReviewSession(ctx, sessionID); session != nil {
+			t.Fatal("session not deleted after commit")
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		uc, sessions, _, sessionID := newTestSessionUsecase(t)
+		completeSteps(t, uc, sessionID)
+		sessions.now = func() time.Time { return time.Now().Add(ReviewSessionTTL + time.Second) }
+		if _, err := uc.CommitReviewSession(ctx, sessionID, 1, 0); !v1.IsReviewSessionNotFound(err) {
+			t.Fatalf("err = %v, want REVIEW_SESSION_NOT_FOUND", err)
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package biz
+
+import (
+	"context"
+
+	v1 "review-service/api/review/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// maxBulkProductStats 一次最多查询的商品数
+const maxBulkProductStats = 200
+
+// ProductStatSummary 商品的评价统计，只统计审核通过的评价
+type ProductStatSummary struct {
+	ProductID   int64
+	AvgScore    float64
+	ReviewCount int64
+	// HasVerifiedPurchase 存在验证购买的评价(is_verified_purchase=1)
+	HasVerifiedPurchase bool
+}
+
+// BulkGetProductStats 批量获取商品（spu）的评价统计，一条SQL查出所有商品
+// 没有评价的商品返回零值统计
+func (uc *ReviewUsecase) BulkGetProductStats(ctx context.Context, productIDs []int64) (map[int64]*ProductStatSummary, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] BulkGetProductStats len(productIDs):%v", len(productIDs))
+	if len(productIDs) == 0 || len(productIDs) > maxBulkProductStats {
+		return nil, errors.BadRequest("INVALID_PRODUCT_IDS", "商品数量取值范围为[1, 200]")
+	}
+	stats, err := uc.repo.GetProductStats(ctx, productIDs)
+	if err != nil {
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+	ret := make(map[int64]*ProductStatSummary, len(productIDs))
+	for _, id := range productIDs {
+		ret[id] = &ProductStatSummary{ProductID: id}
+	}
+	for _, s := range stats {
+		ret[s.ProductID] = s
+	}
+	return ret, nil
+}
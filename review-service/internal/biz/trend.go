@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// 店铺的热门标签：最近24小时评价的标签数与之前一周的日均数对比
+// TrendScore = (TodayCount - WeeklyAvg) / max(WeeklyAvg, 1)
+
+// TrendingTagsTTL 热门标签缓存时间
+const TrendingTagsTTL = 30 * time.Minute
+
+// TrendingTag 热门标签
+type TrendingTag struct {
+	Tag        *model.ReviewTagInfo `json:"tag"`
+	TodayCount int64                `json:"today_count"`
+	WeeklyAvg  float64              `json:"weekly_avg"`
+	TrendScore float64              `json:"trend_score"`
+}
+
+type TagTrendRepo interface {
+	// CountTagsByStore 店铺在[from, to)内创建的评价上每个标签的使用次数
+	CountTagsByStore(ctx context.Context, storeID int64, from, to time.Time) (map[int64]int64, error)
+	ListReviewTags(ctx context.Context, tagIDs []int64) ([]*model.ReviewTagInfo, error)
+	// GetCachedTrendingTags 缓存不存在时返回(nil, nil)
+	GetCachedTrendingTags(ctx context.Context, storeID int64, topN int) ([]*TrendingTag, error)
+	SaveTrendingTags(ctx context.Context, storeID int64, topN int, tags []*TrendingTag, ttl time.Duration) error
+}
+
+type TagTrendUsecase struct {
+	repo TagTrendRepo
+	log  *log.Helper
+}
+
+func NewTagTrendUsecase(repo TagTrendRepo, logger log.Logger) *TagTrendUsecase {
+	return &TagTrendUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// DetectTrendingTags 按TrendScore从高到低返回店铺前topN个标签，结果缓存30分钟
+func (uc *TagTrendUsecase) DetectTrendingTags(ctx context.Context, storeID int64, topN int) ([]*TrendingTag, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] DetectTrendingTags storeID:%v topN:%v", storeID, topN)
+	if tags, err := uc.repo.GetCachedTrendingTags(ctx, storeID, topN); err != nil {
+		uc.log.WithContext(ctx).Warnf("GetCachedTrendingTags fail, err:%v", err)
+	} else if tags != nil {
+		return tags, nil
+	}
+
+	now := time.Now()
+	dayAgo := now.Add(-24 * time.Hour)
+	weekBefore := dayAgo.Add(-7 * 24 * time.Hour)
+	var today, week map[int64]int64
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		today, err = uc.repo.CountTagsByStore(gctx, storeID, dayAgo, now)
+		return err
+	})
+	g.Go(func() (err error) {
+		week, err = uc.repo.CountTagsByStore(gctx, storeID, weekBefore, dayAgo)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		uc.log.WithContext(ctx).Errorf("DetectTrendingTags count fail, err:%v", err)
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+
+	trends := rankTrendingTags(today, week, topN)
+	if len(trends) > 0 {
+		ids := make([]int64, 0, len(trends))
+		for _, t := range trends {
+			ids = append(ids, t.Tag.TagID)
+		}
+		infos, err := uc.repo.ListReviewTags(ctx, ids)
+		if err != nil {
+			return nil, v1.ErrorDbFailed("查询数据库失败")
+		}
+		byID := make(map[int64]*model.ReviewTagInfo, len(infos))
+		for _, info := range infos {
+			byID[info.TagID] = info
+		}
+		for _, t := range trends {
+			if info, ok := byID[t.Tag.TagID]; ok {
+				t.Tag = info
+			}
+		}
+	}
+
+	if err := uc.repo.SaveTrendingTags(ctx, storeID, topN, trends, TrendingTagsTTL); err != nil {
+		uc.log.WithContext(ctx).Warnf("SaveTrendingTags fail, err:%v", err)
+	}
+	return trends, nil
+}
+
+// rankTrendingTags 计算两个时间段里出现过的所有标签的TrendScore，取前topN，分数相同时按标签ID排序
+func rankTrendingTags(today, week map[int64]int64, topN int) []*TrendingTag {
+	trends := make([]*TrendingTag, 0, len(today)+len(week))
+	add := func(tagID int64) {
+		weeklyAvg := float64(week[tagID]) / 7
+		trends = append(trends, &TrendingTag{
+			Tag:        &model.ReviewTagInfo{TagID: tagID},
+			TodayCount: today[tagID],
+			WeeklyAvg:  weeklyAvg,
+			TrendScore: (float64(today[tagID]) - weeklyAvg) / math.Max(weeklyAvg, 1),
+		})
+	}
+	for tagID := range today {
+		add(tagID)
+	}
+	for tagID := range week {
+		if _, ok := today[tagID]; !ok {
+			add(tagID)
+		}
+	}
+	sort.Slice(trends, func(i, j int) bool {
+		if trends[i].TrendScore != trends[j].TrendScore {
+			return trends[i].TrendScore > trends[j].TrendScore
+		}
+		return trends[i].Tag.TagID < trends[j].Tag.TagID
+	})
+	if len(trends) > topN {
+		trends = trends[:topN]
+	}
+	return trends
+}
@@ -0,0 +1,120 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"review-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeTagTrendRepo 按查询区间长度区分最近24小时和之前一周，返回固定的标签计数
+type fakeTagTrendRepo struct {
+	today, week map[int64]int64
+
+	mu         sync.Mutex
+	countCalls int
+	cache      map[string][]*TrendingTag
+}
+
+func (r *fakeTagTrendRepo) CountTagsByStore(_ context.Context, _ int64, from, to time.Time) (map[int64]int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.countCalls++
+	if to.Sub(from) <= 24*time.Hour {
+		return r.today, nil
+	}
+	return r.week, nil
+}
+
+func (r *fakeTagTrendRepo) ListReviewTags(_ context.Context, tagIDs []int64) ([]*model.ReviewTagInfo, error) {
+	tags := make([]*model.ReviewTagInfo, 0, len(tagIDs))
+	for _, id := range tagIDs {
+		tags = append(tags, &model.ReviewTagInfo{TagID: id, Name: fmt.Sprintf("tag-%d", id)})
+	}
+	return tags, nil
+}
+
+func (r *fakeTagTrendRepo) GetCachedTrendingTags(_ context.Context, storeID int64, topN int) ([]*TrendingTag, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cache[fmt.Sprintf("%d:%d", storeID, topN)], nil
+}
+
+func (r *fakeTagTrendRepo) SaveTrendingTags(_ context.Context, storeID int64, topN int, tags []*TrendingTag, _ time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache[fmt.Sprintf("%d:%d", storeID, topN)] = tags
+	return nil
+}
+
+func newFakeTagTrendRepo() *fakeTagTrendRepo {
+	return &fakeTagTrendRepo{
+		// 标签1：今天10次，周均1次，分数9
+		// 标签2：今天3次，上周没有，周均按1计算，分数3
+		// 标签5：今天6次，周均5次，分数0.2
+		// 标签3：今天2次，周均10次，分数-0.8
+		// 标签4：今天没有，周均2次，分数-1
+		today: map[int64]int64{1: 10, 2: 3, 3: 2, 5: 6},
+		week:  map[int64]int64{1: 7, 3: 70, 4: 14, 5: 35},
+		cache: make(map[string][]*TrendingTag),
+	}
+}
+
+func TestDetectTrendingTagsRanking(t *testing.T) {
+	tests := []struct {
+		topN int
+		want []int64
+	}{
+		{1, []int64{1}},
+		{3, []int64{1, 2, 5}},
+		{10, []int64{1, 2, 5, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("top%d", tt.topN), func(t *testing.T) {
+			uc := NewTagTrendUsecase(newFakeTagTrendRepo(), log.DefaultLogger)
+			got, err := uc.DetectTrendingTags(context.Background(), 1, tt.topN)
+			if err != nil {
+				t.Fatalf("DetectTrendingTags fail, err:%v", err)
+			}
+			ids := make([]int64, 0, len(got))
+			for _, tag := range got {
+				ids = append(ids, tag.Tag.TagID)
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(tt.want) {
+				t.Fatalf("ranking = %v, want %v", ids, tt.want)
+			}
+			top := got[0]
+			if top.Tag.Name != "tag-1" || top.TodayCount != 10 || top.WeeklyAvg != 1 || top.TrendScore != 9 {
+				t.Fatalf("top tag = %+v (tag %+v), want tag-1 today 10 weeklyAvg 1 score 9", *top, *top.Tag)
+			}
+		})
+	}
+}
+
+func TestDetectTrendingTagsCached(t *testing.T) {
+	repo := newFakeTagTrendRepo()
+	uc := NewTagTrendUsecase(repo, log.DefaultLogger)
+	ctx := context.Background()
+
+	first, err := uc.DetectTrendingTags(ctx, 1, 3)
+	if err != nil {
+		t.Fatalf("DetectTrendingTags fail, err:%v", err)
+	}
+	// 缓存有效期内计数变化不影响结果，也不再查库
+	repo.today[4] = 100
+	second, err := uc.DetectTrendingTags(ctx, 1, 3)
+	if err != nil {
+		t.Fatalf("DetectTrendingTags again fail, err:%v", err)
+	}
+	if repo.countCalls != 2 {
+		t.Fatalf("CountTagsByStore called %d times, want 2 (today and week, once)", repo.countCalls)
+	}
+	if fmt.Sprint(second) != fmt.Sprint(first) {
+		t.Fatalf("cached result = %v, want %v", second, first)
+	}
+}
@@ -0,0 +1,113 @@
+package biz
+
+import (
+	"context"
+	"errors"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	pkgerrors "review-service/pkg/errors"
+	"review-service/pkg/snowflake"
+
+	"gorm.io/gorm"
+)
+
+// 评价撤回的状态流转：
+// 审核通过(20) -申请-> 撤回审核中(50) -同意-> 已撤回(60)
+// 撤回审核中(50) -拒绝-> 审核通过(20)
+// 每个评价只能申请一次撤回，被拒绝后也不能再次申请
+
+// ErrStatusChanged 更新时数据状态已被其他请求修改，由data层在条件更新未命中时返回
+var ErrStatusChanged = errors.New("status changed")
+
+// 撤回申请状态，与review_withdrawal_info.status一致
+const (
+	WithdrawalStatusPending  = 10 // 待审核
+	WithdrawalStatusApproved = 20 // 同意撤回
+	WithdrawalStatusDenied   = 30 // 拒绝撤回
+)
+
+// RequestWithdrawal 用户申请撤回自己已审核通过的评价
+func (uc *ReviewUsecase) RequestWithdrawal(ctx context.Context, reviewID, userID int64, reason string) (*model.ReviewWithdrawalInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] RequestWithdrawal reviewID:%v userID:%v", reviewID, userID)
+	// 1. 校验评价归属和状态
+	review, err := uc.repo.GetReview(ctx, reviewID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFoundError("review", reviewID)
+		}
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+	if review.UserID != userID {
+		return nil, v1.ErrorWithdrawalNotAllowed("只能撤回自己的评价")
+	}
+	// 2. 一个评价只能申请一次撤回
+	withdrawals, err := uc.repo.GetWithdrawalByReviewID(ctx, reviewID)
+	if err != nil {
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+	if len(withdrawals) > 0 {
+		return nil, v1.ErrorWithdrawalRequested("评价:%d已申请过撤回", reviewID)
+	}
+	if review.Status != ReviewStatusApproved {
+		return nil, v1.ErrorWithdrawalNotAllowed("只有审核通过的评价可以撤回")
+	}
+	// 3. 创建撤回申请，同时把评价置为撤回审核中
+	withdrawal := &model.ReviewWithdrawalInfo{
+		WithdrawalID: snowflake.GenID(),
+		ReviewID:     reviewID,
+		UserID:       userID,
+		Reason:       reason,
+		Status:       WithdrawalStatusPending,
+	}
+	if err := uc.repo.SaveWithdrawal(ctx, withdrawal); err != nil {
+		if errors.Is(err, ErrStatusChanged) {
+			return nil, v1.ErrorWithdrawalNotAllowed("评价状态已变化，请刷新后重试")
+		}
+		return nil, v1.ErrorDbFailed("写入数据库失败")
+	}
+	return withdrawal, nil
+}
+
+// ApproveWithdrawal 同意撤回，评价变为已撤回
+func (uc *ReviewUsecase) ApproveWithdrawal(ctx context.Context, param *AuditWithdrawalParam) (*model.ReviewWithdrawalInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ApproveWithdrawal param:%v", param)
+	return uc.auditWithdrawal(ctx, param, WithdrawalStatusApproved, ReviewStatusWithdrawn)
+}
+
+// DenyWithdrawal 拒绝撤回，评价恢复为审核通过
+func (uc *ReviewUsecase) DenyWithdrawal(ctx context.Context, param *AuditWithdrawalParam) (*model.ReviewWithdrawalInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] DenyWithdrawal param:%v", param)
+	return uc.auditWithdrawal(ctx, param, WithdrawalStatusDenied, ReviewStatusApproved)
+}
+
+func (uc *ReviewUsecase) auditWithdrawal(ctx context.Context, param *AuditWithdrawalParam, status, reviewStatus int32) (*model.ReviewWithdrawalInfo, error) {
+	withdrawal, err := uc.repo.GetWithdrawal(ctx, param.WithdrawalID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFoundError("withdrawal", param.WithdrawalID)
+		}
+		return nil, v1.ErrorDbFailed("查询数据库失败")
+	}
+	if withdrawal.Status != WithdrawalStatusPending {
+		return nil, v1.ErrorWithdrawalNotAllowed("撤回申请:%d已审核", param.WithdrawalID)
+	}
+	withdrawal.Status = status
+	withdrawal.OpUser = param.OpUser
+	withdrawal.OpRemarks = param.OpRemarks
+	if err := uc.repo.AuditWithdrawal(ctx, withdrawal, reviewStatus); err != nil {
+		if errors.Is(err, ErrStatusChanged) {
+			return nil, v1.ErrorWithdrawalNotAllowed("撤回申请:%d已审核", param.WithdrawalID)
+		}
+		return nil, v1.ErrorDbFailed("写入数据库失败")
+	}
+	// 已撤回的评价不能再被取出审核
+	if reviewStatus == ReviewStatusWithdrawn {
+		if review, err := uc.repo.GetReview(ctx, withdrawal.ReviewID); err != nil {
+			uc.log.WithContext(ctx).Warnf("GetReview fail, reviewID:%v err:%v", withdrawal.ReviewID, err)
+		} else if err := uc.queue.RemoveFromModeration(ctx, review.StoreID, review.ReviewID); err != nil {
+			uc.log.WithContext(ctx).Warnf("RemoveFromModeration fail, reviewID:%v err:%v", review.ReviewID, err)
+		}
+	}
+	return withdrawal, nil
+}
@@ -0,0 +1,94 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+)
+
+func TestWithdrawalStateMachine(t *testing.T) {
+	const (
+		reviewID = 1001
+		userID   = 2001
+	)
+	tests := []struct {
+		name       string
+		audit      func(uc *ReviewUsecase, ctx context.Context, param *AuditWithdrawalParam) (*model.ReviewWithdrawalInfo, error)
+		wantStatus int32
+		wantReview int32
+		wantQueued int64
+	}{
+		{"approve", (*ReviewUsecase).ApproveWithdrawal, WithdrawalStatusApproved, ReviewStatusWithdrawn, 0},
+		{"deny", (*ReviewUsecase).DenyWithdrawal, WithdrawalStatusDenied, ReviewStatusApproved, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := newFakeReviewRepo(&model.ReviewInfo{ReviewID: reviewID, UserID: userID, StoreID: 1, Status: ReviewStatusApproved})
+			uc := newTestReviewUsecase(repo)
+			// 队列里残留的评价在撤回后要被移除
+			if err := uc.queue.repo.PushModeration(ctx, 1, reviewID, 1); err != nil {
+				t.Fatalf("PushModeration err: %v", err)
+			}
+
+			withdrawal, err := uc.RequestWithdrawal(ctx, reviewID, userID, "写错了")
+			if err != nil {
+				t.Fatalf("RequestWithdrawal err: %v", err)
+			}
+			if withdrawal.Status != WithdrawalStatusPending {
+				t.Fatalf("withdrawal status = %d, want %d", withdrawal.Status, WithdrawalStatusPending)
+			}
+			if review, _ := repo.GetReview(ctx, reviewID); review.Status != ReviewStatusWithdrawalPending {
+				t.Fatalf("review status = %d, want %d", review.Status, ReviewStatusWithdrawalPending)
+			}
+
+			param := &AuditWithdrawalParam{WithdrawalID: withdrawal.WithdrawalID, OpUser: "op"}
+			audited, err := tt.audit(uc, ctx, param)
+			if err != nil {
+				t.Fatalf("audit err: %v", err)
+			}
+			if audited.Status != tt.wantStatus {
+				t.Fatalf("withdrawal status = %d, want %d", audited.Status, tt.wantStatus)
+			}
+			if review, _ := repo.GetReview(ctx, reviewID); review.Status != tt.wantReview {
+				t.Fatalf("review status = %d, want %d", review.Status, tt.wantReview)
+			}
+			if depth, _ := uc.queue.GetQueueDepth(ctx, 1); depth != tt.wantQueued {
+				t.Fatalf("queue depth = %d, want %d", depth, tt.wantQueued)
+			}
+
+			// 已审核的撤回申请不能再次审核
+			for _, again := range tests {
+				if _, err := again.audit(uc, ctx, param); !v1.IsWithdrawalNotAllowed(err) {
+					t.Fatalf("%s after %s: err = %v, want WITHDRAWAL_NOT_ALLOWED", again.name, tt.name, err)
+				}
+			}
+			// 一个评价只能申请一次撤回，被拒绝后也不能再申请
+			if _, err := uc.RequestWithdrawal(ctx, reviewID, userID, "再试一次"); !v1.IsWithdrawalRequested(err) {
+				t.Fatalf("second RequestWithdrawal err = %v, want WITHDRAWAL_REQUESTED", err)
+			}
+		})
+	}
+}
+
+func TestRequestWithdrawalRejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		review *model.ReviewInfo
+		userID int64
+	}{
+		{"other user", &model.ReviewInfo{ReviewID: 1, UserID: 10, Status: ReviewStatusApproved}, 11},
+		{"pending audit", &model.ReviewInfo{ReviewID: 1, UserID: 10, Status: 10}, 10},
+		{"rejected", &model.ReviewInfo{ReviewID: 1, UserID: 10, Status: ReviewStatusRejected}, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newTestReviewUsecase(newFakeReviewRepo(tt.review))
+			if _, err := uc.RequestWithdrawal(context.Background(), tt.review.ReviewID, tt.userID, ""); !v1.IsWithdrawalNotAllowed(err) {
+				t.Fatalf("err = %v, want WITHDRAWAL_NOT_ALLOWED", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"review-service/internal/biz"
+	"review-service/pkg/env"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// slackWebhookEnv Slack incoming webhook的地址，webhook地址本身就是凭证，不写进配置文件
+	slackWebhookEnv = "SLACK_WEBHOOK_URL"
+	// slackTimeout 发送一条告警的超时时间
+	slackTimeout = 5 * time.Second
+)
+
+// slackNotifier 通过Slack incoming webhook发送告警，高优先级的告警@channel
+// 未配置webhook时只写日志
+type slackNotifier struct {
+	webhook string
+	client  *http.Client
+	log     *log.Helper
+}
+
+// NewAlertNotifier .
+func NewAlertNotifier(logger log.Logger) biz.AlertNotifier {
+	return &slackNotifier{
+		webhook: env.OptionalString(slackWebhookEnv, ""),
+		client:  &http.Client{Timeout: slackTimeout},
+		log:     log.NewHelper(logger),
+	}
+}
+
+func (n *slackNotifier) Notify(ctx context.Context, alert *biz.Alert) error {
+	text := fmt.Sprintf("*%s*\n%s", alert.Title, alert.Text)
+	if alert.Priority == biz.AlertPriorityHigh {
+		text = "<!channel> :rotating_light: " + text
+	}
+	if n.webhook == "" {
+		n.log.WithContext(ctx).Warnf("alert (%s not set): %s", slackWebhookEnv, text)
+		return nil
+	}
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack webhook status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"review-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestSlackNotifier(t *testing.T) {
+	var texts []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode webhook body fail, err:%v", err)
+		}
+		texts = append(texts, body["text"])
+	}))
+	defer srv.Close()
+	t.Setenv(slackWebhookEnv, srv.URL)
+	notifier := NewAlertNotifier(log.DefaultLogger)
+
+	ctx := context.Background()
+	if err := notifier.Notify(ctx, &biz.Alert{Priority: biz.AlertPriorityNormal, Title: "t1", Text: "normal"}); err != nil {
+		t.Fatalf("Notify fail, err:%v", err)
+	}
+	if err := notifier.Notify(ctx, &biz.Alert{Priority: biz.AlertPriorityHigh, Title: "t2", Text: "urgent"}); err != nil {
+		t.Fatalf("Notify fail, err:%v", err)
+	}
+	want := []string{"*t1*\nnormal", "<!channel> :rotating_light: *t2*\nurgent"}
+	if fmt.Sprint(texts) != fmt.Sprint(want) {
+		t.Fatalf("webhook texts = %q, want %q", texts, want)
+	}
+}
@@ -0,0 +1,73 @@
+package data
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestCompareReviewPeriods(t *testing.T) {
+	repo, dbs := newTestShardedRepo(t, 1)
+	uc := biz.NewReviewUsecase(repo, nil, nil, &conf.Business{}, log.DefaultLogger)
+	ctx := context.Background()
+
+	day := func(month time.Month, d int) time.Time { return time.Date(2026, month, d, 12, 0, 0, 0, time.UTC) }
+	var reviewID int64
+	add := func(storeID int64, score, status int32, createAt time.Time) {
+		reviewID++
+		review := &model.ReviewInfo{ReviewID: reviewID, OrderID: reviewID, StoreID: storeID, Score: score, Status: status, CreateAt: createAt, UpdateAt: createAt}
+		if err := dbs[0].Create(review).Error; err != nil {
+			t.Fatalf("seed review fail, err:%v", err)
+		}
+	}
+	// 活动前（2月）两条低分评价，活动后（3月）三条高分评价
+	add(1, 2, biz.ReviewStatusApproved, day(2, 1))
+	add(1, 3, biz.ReviewStatusApproved, day(2, 20))
+	add(1, 5, biz.ReviewStatusApproved, day(3, 2))
+	add(1, 5, biz.ReviewStatusApproved, day(3, 10))
+	add(1, 4, biz.ReviewStatusApproved, day(3, 30))
+	// 已撤回的评价和其他店铺的评价不计入
+	add(1, 5, biz.ReviewStatusWithdrawn, day(2, 5))
+	add(2, 1, biz.ReviewStatusApproved, day(3, 5))
+
+	feb := biz.DateRange{From: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}
+	mar := biz.DateRange{From: feb.To, To: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)}
+	got, err := uc.CompareReviewPeriods(ctx, 1, feb, mar)
+	if err != nil {
+		t.Fatalf("CompareReviewPeriods fail, err:%v", err)
+	}
+	tests := []struct {
+		name string
+		got  *biz.PeriodStats
+		want biz.PeriodStats
+	}{
+		{"period A", got.PeriodA, biz.PeriodStats{AvgScore: 2.5, ReviewCount: 2}},
+		{"period B", got.PeriodB, biz.PeriodStats{AvgScore: 14.0 / 3, ReviewCount: 3}},
+	}
+	for _, tt := range tests {
+		if tt.got.ReviewCount != tt.want.ReviewCount || math.Abs(tt.got.AvgScore-tt.want.AvgScore) > 1e-9 {
+			t.Errorf("%s = %+v, want %+v", tt.name, *tt.got, tt.want)
+		}
+	}
+
+	// 没有评价的时间段平均分为0
+	empty := biz.DateRange{From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)}
+	got, err = uc.CompareReviewPeriods(ctx, 1, empty, mar)
+	if err != nil {
+		t.Fatalf("CompareReviewPeriods empty period fail, err:%v", err)
+	}
+	if *got.PeriodA != (biz.PeriodStats{}) {
+		t.Errorf("empty period = %+v, want zero stats", *got.PeriodA)
+	}
+
+	if _, err := uc.CompareReviewPeriods(ctx, 1, biz.DateRange{From: mar.To, To: mar.From}, feb); err == nil {
+		t.Error("CompareReviewPeriods with reversed period err = nil, want INVALID_PERIOD")
+	}
+}
@@ -1,46 +1,144 @@
 package data
 
 import (
+	"context"
 	"errors"
 	"review-service/internal/conf"
 	"review-service/internal/data/query"
+	"review-service/pkg/env"
+	"review-service/pkg/middleware"
 	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	mysqldriver "github.com/go-sql-driver/mysql"
 	"github.com/google/wire"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
+// lagCheckInterval 从库延迟检查间隔
+const lagCheckInterval = 5 * time.Second
+
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewReviewRepo, NewDB)
+var ProviderSet = wire.NewSet(NewData, NewReviewRepo, NewReviewSessionRepo, NewRollupRepo, NewModerationQueueRepo, NewReportRepo, NewTagTrendRepo, NewSentimentRepo, NewAlertNotifier, NewDB, NewRedisClient, NewLagMonitor, NewReplicationLagProbe)
 
 // Data .
 type Data struct {
 	// TODO wrapped database client
 	// db *gorm.DB
 	query *query.Query
+	rdb   *redis.Client
 	log   *log.Helper
 }
 
 // NewData .
-func NewData(db *gorm.DB, logger log.Logger) (*Data, func(), error) {
-	cleanup := func() {
-		log.NewHelper(logger).Info("closing the data resources")
-	}
+func NewData(db *gorm.DB, rdb *redis.Client, logger log.Logger) (*Data, func(), error) {
 	// 非常重要!为GEN生成的query代码设置数据库连接对象
 	query.SetDefault(db)
 
-	return &Data{query: query.Q, log: log.NewHelper(logger)}, cleanup, nil
+	cleanup := func() {
+		log.NewHelper(logger).Info("closing the data resources")
+		rdb.Close()
+	}
+	return &Data{query: query.Q, rdb: rdb, log: log.NewHelper(logger)}, cleanup, nil
 }
 
 func NewDB(cfg *conf.Data) (*gorm.DB, error) {
-	switch strings.ToLower(cfg.Database.GetDriver()) {
+	return openDB(cfg.Database.GetDriver(), cfg.Database.GetSource())
+}
+
+// NewRedisClient 创建Redis客户端，连接在第一次使用时建立
+func NewRedisClient(cfg *conf.Data) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Network:      cfg.Redis.GetNetwork(),
+		Addr:         cfg.Redis.GetAddr(),
+		Password:     env.OptionalString("REDIS_PASSWORD", ""),
+		ReadTimeout:  cfg.Redis.GetReadTimeout().AsDuration(),
+		WriteTimeout: cfg.Redis.GetWriteTimeout().AsDuration(),
+	})
+}
+
+// PingDB 启动前检查数据库能否连接，检查用的连接用完即关闭
+func PingDB(ctx context.Context, cfg *conf.Data) error {
+	db, err := NewDB(cfg)
+	if err != nil {
+		return err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+	return sqlDB.PingContext(ctx)
+}
+
+// PingRedis 启动前检查Redis能否连接
+func PingRedis(ctx context.Context, cfg *conf.Data) error {
+	rdb := NewRedisClient(cfg)
+	defer rdb.Close()
+	return rdb.Ping(ctx).Err()
+}
+
+// NewLagMonitor 配置了从库时监控复制延迟，未配置时返回nil
+func NewLagMonitor(cfg *conf.Data, logger log.Logger) (*middleware.LagMonitor, func(), error) {
+	if cfg.Database.GetReplicaSource() == "" {
+		return nil, func() {}, nil
+	}
+	replica, err := openDB(cfg.Database.GetDriver(), cfg.Database.GetReplicaSource())
+	if err != nil {
+		return nil, nil, err
+	}
+	sqlDB, err := replica.DB()
+	if err != nil {
+		return nil, nil, err
+	}
+	monitor := middleware.NewLagMonitor(sqlDB, lagCheckInterval)
+	cleanup := func() {
+		log.NewHelper(logger).Info("closing the replica lag monitor")
+		monitor.Close()
+		sqlDB.Close()
+	}
+	return monitor, cleanup, nil
+}
+
+// openDB 打开数据库连接，并注册过滤复制延迟探测行的查询回调
+func openDB(driver, source string) (*gorm.DB, error) {
+	var dialector gorm.Dialector
+	switch strings.ToLower(driver) {
 	case "mysql":
-		return gorm.Open(mysql.Open(cfg.Database.GetSource()))
+		dsn, err := mysqlDSN(source)
+		if err != nil {
+			return nil, err
+		}
+		dialector = mysql.Open(dsn)
 	case "sqlite":
-		return gorm.Open(sqlite.Open(cfg.Database.GetSource()))
+		dialector = sqlite.Open(source)
+	default:
+		return nil, errors.New("connect db fail unsupported db driver")
+	}
+	db, err := gorm.Open(dialector)
+	if err != nil {
+		return nil, err
+	}
+	if err := registerProbeFilter(db); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// mysqlDSN 设置了DATABASE_PASSWORD环境变量时，用它替换DSN中的密码
+func mysqlDSN(source string) (string, error) {
+	password := env.OptionalString("DATABASE_PASSWORD", "")
+	if password == "" {
+		return source, nil
+	}
+	cfg, err := mysqldriver.ParseDSN(source)
+	if err != nil {
+		return "", err
 	}
-	return nil, errors.New("connect db fail unsupported db driver")
+	cfg.Passwd = password
+	return cfg.FormatDSN(), nil
 }
@@ -0,0 +1,27 @@
+package data
+
+import "testing"
+
+func TestMySQLDSNPassword(t *testing.T) {
+	const source = "root:old@tcp(127.0.0.1:3306)/review?parseTime=True"
+	tests := []struct {
+		name     string
+		password string
+		want     string
+	}{
+		{"no env keeps source", "", source},
+		{"env replaces password", "p@ss:word", "root:p@ss:word@tcp(127.0.0.1:3306)/review?parseTime=true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("DATABASE_PASSWORD", tt.password)
+			got, err := mysqlDSN(source)
+			if err != nil {
+				t.Fatalf("mysqlDSN fail, err:%v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("mysqlDSN() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"review-service/internal/data/model"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 评价关键词索引，给全平台统计报表的TopKeywords使用
+// 每天一个有序集合，member为关键词，score为当天包含该关键词的评价数，评价入库时写入
+// 中文按相邻两个字切分（二元切分），其他文字按连续的字母数字切分，同一条评价中重复的关键词只计一次
+
+const (
+	keywordIndexKeyPrefix = "review:keywords:"
+	// keywordIndexTTL 索引保留时间，超过保留时间的统计区间拿不到关键词
+	keywordIndexTTL = 400 * 24 * time.Hour
+	// keywordMinLen 非中文关键词的最小长度
+	keywordMinLen = 2
+)
+
+// keywordStopWords 不计入索引的常见词
+var keywordStopWords = map[string]struct{}{
+	"the": {}, "and": {}, "is": {}, "it": {}, "to": {}, "of": {}, "in": {}, "for": {}, "this": {}, "that": {},
+	"非常": {}, "就是": {}, "还是": {}, "一个": {}, "没有": {}, "我们": {}, "你们": {}, "他们": {}, "这个": {}, "那个": {},
+}
+
+func keywordIndexKey(day time.Time) string {
+	return keywordIndexKeyPrefix + day.Format("20060102")
+}
+
+// keywordIndexKeys 覆盖[from, to)的每天的索引key
+func keywordIndexKeys(from, to time.Time) []string {
+	if !from.Before(to) {
+		return nil
+	}
+	var keys []string
+	for day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location()); day.Before(to); day = day.AddDate(0, 0, 1) {
+		keys = append(keys, keywordIndexKey(day))
+	}
+	return keys
+}
+
+// extractKeywords 切分评价内容，返回去重后的关键词
+func extractKeywords(content string) []string {
+	seen := make(map[string]struct{})
+	var keywords []string
+	add := func(word string) {
+		if _, ok := keywordStopWords[word]; ok {
+			return
+		}
+		if _, ok := seen[word]; ok {
+			return
+		}
+		seen[word] = struct{}{}
+		keywords = append(keywords, word)
+	}
+	runes := []rune(strings.ToLower(content))
+	for i := 0; i < len(runes); {
+		switch {
+		case unicode.Is(unicode.Han, runes[i]):
+			j := i
+			for j < len(runes) && unicode.Is(unicode.Han, runes[j]) {
+				j++
+			}
+			for k := i; k+1 < j; k++ {
+				add(string(runes[k : k+2]))
+			}
+			i = j
+		case unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]):
+			j := i
+			for j < len(runes) && !unicode.Is(unicode.Han, runes[j]) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j])) {
+				j++
+			}
+			if j-i >= keywordMinLen {
+				add(string(runes[i:j]))
+			}
+			i = j
+		default:
+			i++
+		}
+	}
+	return keywords
+}
+
+// indexReviewKeywords 把评价的关键词计入评价创建当天的索引
+func indexReviewKeywords(ctx context.Context, rdb *redis.Client, review *model.ReviewInfo) error {
+	keywords := extractKeywords(review.Content)
+	if len(keywords) == 0 {
+		return nil
+	}
+	day := review.CreateAt
+	if day.IsZero() {
+		day = time.Now()
+	}
+	key := keywordIndexKey(day)
+	pipe := rdb.Pipeline()
+	for _, keyword := range keywords {
+		pipe.ZIncrBy(ctx, key, 1, keyword)
+	}
+	pipe.Expire(ctx, key, keywordIndexTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// topKeywords 合并[from, to)每天的索引，返回评价数最多的n个关键词
+func topKeywords(ctx context.Context, rdb *redis.Client, from, to time.Time, n int) ([]string, error) {
+	keys := keywordIndexKeys(from, to)
+	if len(keys) == 0 || n <= 0 {
+		return nil, nil
+	}
+	// 合并结果写到临时key，取完即删
+	dest := keywordIndexKeyPrefix + "union:" + strconv.FormatInt(from.Unix(), 10) + ":" + strconv.FormatInt(to.Unix(), 10)
+	pipe := rdb.TxPipeline()
+	pipe.ZUnionStore(ctx, dest, &redis.ZStore{Keys: keys})
+	top := pipe.ZRevRange(ctx, dest, 0, int64(n-1))
+	pipe.Del(ctx, dest)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return top.Val(), nil
+}
@@ -0,0 +1,38 @@
+package data
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestExtractKeywords(t *testing.T) {
+	tests := []struct {
+		content string
+		want    []string
+	}{
+		{"质量很好", []string{"质量", "量很", "很好"}},
+		{"质量好，质量好！", []string{"质量", "量好"}},
+		{"iPhone 15 Pro is OK", []string{"iphone", "15", "pro", "ok"}},
+		{"包装ok, 5星", []string{"包装", "ok"}},
+		{"非常", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := extractKeywords(tt.content); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("extractKeywords(%q) = %q, want %q", tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestKeywordIndexKeys(t *testing.T) {
+	from := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	got := keywordIndexKeys(from, time.Date(2026, 10, 3, 1, 0, 0, 0, time.UTC))
+	want := []string{"review:keywords:20261001", "review:keywords:20261002", "review:keywords:20261003"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("keywordIndexKeys = %q, want %q", got, want)
+	}
+	if got := keywordIndexKeys(from, from); got != nil {
+		t.Fatalf("empty period keys = %q, want nil", got)
+	}
+}
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+// review_info行的结构版本，读出旧版本的行时按顺序执行每一次升级的迁移函数，升级成当前版本的结构
+// 修改ReviewInfo结构时：CurrentReviewSchemaVersion加1，并用RegisterReviewMigration注册从上一个版本升级的函数
+// 迁移只作用于读出的数据，不会写回数据库
+
+// CurrentReviewSchemaVersion ReviewInfo当前的结构版本
+const CurrentReviewSchemaVersion int16 = 1
+
+// reviewSchemaVersion 读出时升级到的版本，测试中调高它来验证新注册的迁移
+var reviewSchemaVersion = CurrentReviewSchemaVersion
+
+// Migrator 把raw从fromVersion升级到toVersion
+type Migrator interface {
+	Migrate(raw map[string]interface{}, fromVersion, toVersion int16) (map[string]interface{}, error)
+}
+
+// MigrationFunc 把数据从一个版本升级到下一个版本
+type MigrationFunc func(raw map[string]interface{}) (map[string]interface{}, error)
+
+// migrationRegistry 按版本逐级升级的Migrator
+type migrationRegistry struct {
+	mu    sync.RWMutex
+	steps map[int16]MigrationFunc
+}
+
+var reviewMigrator = &migrationRegistry{steps: map[int16]MigrationFunc{}}
+
+// ReviewMigrator ReviewInfo使用的Migrator
+func ReviewMigrator() Migrator {
+	return reviewMigrator
+}
+
+// RegisterReviewMigration 注册从fromVersion升级到fromVersion+1的迁移函数，一般在init中调用
+func RegisterReviewMigration(fromVersion int16, fn MigrationFunc) {
+	reviewMigrator.mu.Lock()
+	defer reviewMigrator.mu.Unlock()
+	reviewMigrator.steps[fromVersion] = fn
+}
+
+func (m *migrationRegistry) Migrate(raw map[string]interface{}, fromVersion, toVersion int16) (map[string]interface{}, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for v := fromVersion; v < toVersion; v++ {
+		fn, ok := m.steps[v]
+		if !ok {
+			return nil, fmt.Errorf("model: no migration registered from schema version %d to %d", v, v+1)
+		}
+		var err error
+		if raw, err = fn(raw); err != nil {
+			return nil, fmt.Errorf("model: migrate schema version %d to %d: %w", v, v+1, err)
+		}
+	}
+	return raw, nil
+}
+
+// AfterFind 读出旧版本的行时升级到当前版本
+func (r *ReviewInfo) AfterFind(tx *gorm.DB) error {
+	return r.UpgradeSchema()
+}
+
+// UpgradeSchema 把旧版本的数据升级到当前版本，从缓存等不经过gorm的地方读出的数据也要调用
+// 只查询部分字段时SchemaVersion为0，不做迁移
+func (r *ReviewInfo) UpgradeSchema() error {
+	if r.SchemaVersion <= 0 || r.SchemaVersion >= reviewSchemaVersion {
+		return nil
+	}
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if raw, err = ReviewMigrator().Migrate(raw, r.SchemaVersion, reviewSchemaVersion); err != nil {
+		return err
+	}
+	if b, err = json.Marshal(raw); err != nil {
+		return err
+	}
+	migrated := new(ReviewInfo)
+	if err := json.Unmarshal(b, migrated); err != nil {
+		return err
+	}
+	migrated.SchemaVersion = reviewSchemaVersion
+	*r = *migrated
+	return nil
+}
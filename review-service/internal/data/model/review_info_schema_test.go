@@ -0,0 +1,92 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// withSchemaVersion 测试期间把当前版本调到version，并注册从version-1升级的迁移
+func withSchemaVersion(t *testing.T, version int16, fn MigrationFunc) {
+	t.Helper()
+	reviewSchemaVersion = version
+	RegisterReviewMigration(version-1, fn)
+	t.Cleanup(func() {
+		reviewSchemaVersion = CurrentReviewSchemaVersion
+		reviewMigrator.mu.Lock()
+		delete(reviewMigrator.steps, version-1)
+		reviewMigrator.mu.Unlock()
+	})
+}
+
+// TestMigrationV1ToV2 v2把pic_info从单个图片地址改成图片地址的json数组，v1的行读出时升级
+func TestMigrationV1ToV2(t *testing.T) {
+	withSchemaVersion(t, 2, func(raw map[string]interface{}) (map[string]interface{}, error) {
+		pic, _ := raw["pic_info"].(string)
+		pics := []string{}
+		if pic != "" {
+			pics = append(pics, pic)
+		}
+		b, err := json.Marshal(pics)
+		if err != nil {
+			return nil, err
+		}
+		raw["pic_info"] = string(b)
+		return raw, nil
+	})
+
+	db, err := gorm.Open(sqlite.Open("file:TestMigrationV1ToV2?mode=memory&cache=shared"))
+	if err != nil {
+		t.Fatalf("open sqlite fail, err:%v", err)
+	}
+	if err := db.AutoMigrate(&ReviewInfo{}); err != nil {
+		t.Fatalf("migrate fail, err:%v", err)
+	}
+	rows := []*ReviewInfo{
+		{ReviewID: 1, Content: "v1 with pic", PicInfo: "a.jpg", SchemaVersion: 1},
+		{ReviewID: 2, Content: "v1 without pic", SchemaVersion: 1},
+		{ReviewID: 3, Content: "already v2", PicInfo: `["b.jpg"]`, SchemaVersion: 2},
+	}
+	if err := db.Create(rows).Error; err != nil {
+		t.Fatalf("seed fail, err:%v", err)
+	}
+
+	tests := []struct {
+		reviewID int64
+		wantPic  string
+	}{
+		{1, `["a.jpg"]`},
+		{2, `[]`},
+		{3, `["b.jpg"]`},
+	}
+	for _, tt := range tests {
+		var got ReviewInfo
+		if err := db.Where("review_id = ?", tt.reviewID).First(&got).Error; err != nil {
+			t.Fatalf("read review %d fail, err:%v", tt.reviewID, err)
+		}
+		if got.PicInfo != tt.wantPic || got.SchemaVersion != 2 {
+			t.Errorf("review %d: pic_info=%q schema_version=%d, want %q 2", tt.reviewID, got.PicInfo, got.SchemaVersion, tt.wantPic)
+		}
+		// 其他字段原样保留
+		if got.ReviewID != tt.reviewID || got.Content == "" {
+			t.Errorf("review %d: fields lost in migration: %+v", tt.reviewID, got)
+		}
+	}
+
+	// 只查询部分字段时不迁移
+	var partial ReviewInfo
+	if err := db.Select("review_id", "pic_info").Where("review_id = ?", 1).First(&partial).Error; err != nil {
+		t.Fatalf("partial read fail, err:%v", err)
+	}
+	if partial.PicInfo != "a.jpg" {
+		t.Errorf("partial read pic_info=%q, want a.jpg unmigrated", partial.PicInfo)
+	}
+}
+
+func TestMigrationMissingStep(t *testing.T) {
+	if _, err := ReviewMigrator().Migrate(map[string]interface{}{}, 1, 3); err == nil {
+		t.Fatal("Migrate without registered steps should fail")
+	}
+}
@@ -0,0 +1,57 @@
+package data
+
+import (
+	"context"
+	"strconv"
+
+	"review-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// 每个店铺一个有序集合，member为评价ID，score为紧急程度
+const moderationQueueKeyPrefix = "modqueue:"
+
+type moderationQueueRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewModerationQueueRepo .
+func NewModerationQueueRepo(data *Data, logger log.Logger) biz.ModerationQueueRepo {
+	return &moderationQueueRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func moderationQueueKey(storeID int64) string {
+	return moderationQueueKeyPrefix + strconv.FormatInt(storeID, 10)
+}
+
+func (r *moderationQueueRepo) PushModeration(ctx context.Context, storeID, reviewID int64, urgency float64) error {
+	return r.data.rdb.ZAdd(ctx, moderationQueueKey(storeID), redis.Z{Score: urgency, Member: reviewID}).Err()
+}
+
+func (r *moderationQueueRepo) PopModeration(ctx context.Context, storeID int64) (int64, error) {
+	zs, err := r.data.rdb.ZPopMax(ctx, moderationQueueKey(storeID)).Result()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("PopModeration fail, storeID:%v err:%v", storeID, err)
+		return 0, err
+	}
+	if len(zs) == 0 {
+		return 0, nil
+	}
+	// ZPOPMAX返回的member是字符串
+	member, _ := zs[0].Member.(string)
+	return strconv.ParseInt(member, 10, 64)
+}
+
+func (r *moderationQueueRepo) RemoveModeration(ctx context.Context, storeID, reviewID int64) error {
+	return r.data.rdb.ZRem(ctx, moderationQueueKey(storeID), reviewID).Err()
+}
+
+func (r *moderationQueueRepo) ModerationDepth(ctx context.Context, storeID int64) (int64, error) {
+	return r.data.rdb.ZCard(ctx, moderationQueueKey(storeID)).Result()
+}
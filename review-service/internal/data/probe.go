@@ -0,0 +1,154 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/pkg/metrics"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 复制延迟探测：定时通过主库写入一条探测评价，然后轮询从库直到能查到，耗时记为复制延迟
+// 探测行用is_probe=1标记，所有连接都注册了查询回调过滤掉探测行，业务查询不会查到
+
+const (
+	// probeInterval 探测间隔
+	probeInterval = 60 * time.Second
+	// probeTimeout 单次探测等待从库的最长时间
+	probeTimeout = 30 * time.Second
+	// probePollInterval 轮询从库的间隔
+	probePollInterval = 10 * time.Millisecond
+	// includeProbeKey 设置后查询不过滤探测行，只有探测本身使用
+	includeProbeKey = "review:include_probe"
+)
+
+// excludeProbe 过滤复制延迟探测行的GORM scope
+func excludeProbe(db *gorm.DB) *gorm.DB {
+	return db.Where("is_probe = ?", 0)
+}
+
+// registerProbeFilter 对review_info的所有查询（Find/First/Count/Scan等）应用excludeProbe
+func registerProbeFilter(db *gorm.DB) error {
+	filter := func(db *gorm.DB) {
+		if db.Statement.Table != model.TableNameReviewInfo {
+			return
+		}
+		if include, ok := db.Get(includeProbeKey); ok && include.(bool) {
+			return
+		}
+		// 回调中的db就是当前语句，直接追加条件
+		excludeProbe(db)
+	}
+	if err := db.Callback().Query().Before("gorm:query").Register("review:exclude_probe", filter); err != nil {
+		return err
+	}
+	return db.Callback().Row().Before("gorm:row").Register("review:exclude_probe", filter)
+}
+
+// ReplicationLagProbe 复制延迟探测，实现了transport.Server，随kratos.App启停
+// 未配置从库时不做探测
+type ReplicationLagProbe struct {
+	primary *gorm.DB
+	replica *gorm.DB
+	log     *log.Helper
+	stop    chan struct{}
+}
+
+// NewReplicationLagProbe .
+func NewReplicationLagProbe(cfg *conf.Data, db *gorm.DB, logger log.Logger) (*ReplicationLagProbe, func(), error) {
+	p := &ReplicationLagProbe{
+		primary: db,
+		log:     log.NewHelper(logger),
+		stop:    make(chan struct{}),
+	}
+	if cfg.Database.GetReplicaSource() == "" {
+		return p, func() {}, nil
+	}
+	replica, err := openDB(cfg.Database.GetDriver(), cfg.Database.GetReplicaSource())
+	if err != nil {
+		return nil, nil, err
+	}
+	p.replica = replica
+	cleanup := func() {
+		if sqlDB, err := replica.DB(); err == nil {
+			sqlDB.Close()
+		}
+	}
+	return p, cleanup, nil
+}
+
+// Start 阻塞运行直到Stop被调用或ctx取消
+func (p *ReplicationLagProbe) Start(ctx context.Context) error {
+	if p.replica == nil {
+		return nil
+	}
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-p.stop:
+			return nil
+		case <-ticker.C:
+			lag, err := p.probe(ctx)
+			if err != nil {
+				p.log.Errorf("ReplicationLagProbe probe fail, err:%v", err)
+				continue
+			}
+			metrics.ReplicationLag(lag)
+		}
+	}
+}
+
+func (p *ReplicationLagProbe) Stop(context.Context) error {
+	close(p.stop)
+	return nil
+}
+
+// probe 写入一条探测行并等待它出现在从库上，返回耗时
+func (p *ReplicationLagProbe) probe(ctx context.Context) (time.Duration, error) {
+	row := &model.ReviewInfo{
+		// 探测行使用负数评价ID，不会和雪花算法生成的ID冲突
+		ReviewID: -time.Now().UnixNano(),
+		Content:  "replication lag probe",
+		CreateBy: "replication_lag_probe",
+		UpdateBy: "replication_lag_probe",
+		IsProbe:  1,
+	}
+	if err := p.primary.WithContext(ctx).Create(row).Error; err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := p.primary.Where("review_id = ?", row.ReviewID).Delete(&model.ReviewInfo{}).Error; err != nil {
+			p.log.Warnf("ReplicationLagProbe delete probe row fail, reviewID:%v err:%v", row.ReviewID, err)
+		}
+	}()
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	for {
+		var count int64
+		err := p.replica.WithContext(ctx).
+			Set(includeProbeKey, true).
+			Model(&model.ReviewInfo{}).
+			Where("review_id = ?", row.ReviewID).
+			Count(&count).Error
+		if err != nil {
+			return 0, err
+		}
+		if count > 0 {
+			return time.Since(start), nil
+		}
+		select {
+		case <-ctx.Done():
+			return 0, fmt.Errorf("probe row not replicated within %v", probeTimeout)
+		case <-time.After(probePollInterval):
+		}
+	}
+}
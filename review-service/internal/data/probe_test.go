@@ -0,0 +1,43 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+)
+
+func TestGetReviewsByStoreExcludesProbe(t *testing.T) {
+	repo, dbs := newTestShardedRepo(t, 1)
+	ctx := context.Background()
+
+	// 店铺1两条正常评价和两条探测行，探测行和正常评价一样是已通过状态
+	for reviewID := int64(1); reviewID <= 4; reviewID++ {
+		review := &model.ReviewInfo{ReviewID: reviewID, OrderID: reviewID, UserID: 7, StoreID: 1, Status: biz.ReviewStatusApproved}
+		if reviewID%2 == 0 {
+			review.IsProbe = 1
+		}
+		if _, err := repo.SaveReview(ctx, review); err != nil {
+			t.Fatalf("SaveReview fail, err:%v", err)
+		}
+	}
+
+	got, err := repo.GetReviewsByStore(ctx, 1, 0, 10)
+	if err != nil {
+		t.Fatalf("GetReviewsByStore fail, err:%v", err)
+	}
+	if want := []int64{3, 1}; fmt.Sprint(reviewIDs(got)) != fmt.Sprint(want) {
+		t.Fatalf("GetReviewsByStore = %v, want %v", reviewIDs(got), want)
+	}
+
+	// 探测行确实写进了库，只有探测本身能查到
+	var count int64
+	if err := dbs[0].Set(includeProbeKey, true).Model(&model.ReviewInfo{}).Count(&count).Error; err != nil {
+		t.Fatalf("count reviews fail, err:%v", err)
+	}
+	if count != 4 {
+		t.Fatalf("review_info has %d rows including probes, want 4", count)
+	}
+}
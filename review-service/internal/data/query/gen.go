@@ -16,44 +16,69 @@ import (
 )
 
 var (
-	Q                = new(Query)
-	ReviewAppealInfo *reviewAppealInfo
-	ReviewInfo       *reviewInfo
-	ReviewReplyInfo  *reviewReplyInfo
+	Q                    = new(Query)
+	ReviewAppealInfo     *reviewAppealInfo
+	ReviewAuditLog       *reviewAuditLog
+	ReviewCreationRollup *reviewCreationRollup
+	ReviewInfo           *reviewInfo
+	ReviewReplyInfo      *reviewReplyInfo
+	ReviewTagInfo        *reviewTagInfo
+	ReviewTagMap         *reviewTagMap
+	ReviewWithdrawalInfo *reviewWithdrawalInfo
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	ReviewAppealInfo = &Q.ReviewAppealInfo
+	ReviewAuditLog = &Q.ReviewAuditLog
+	ReviewCreationRollup = &Q.ReviewCreationRollup
 	ReviewInfo = &Q.ReviewInfo
 	ReviewReplyInfo = &Q.ReviewReplyInfo
+	ReviewTagInfo = &Q.ReviewTagInfo
+	ReviewTagMap = &Q.ReviewTagMap
+	ReviewWithdrawalInfo = &Q.ReviewWithdrawalInfo
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:               db,
-		ReviewAppealInfo: newReviewAppealInfo(db, opts...),
-		ReviewInfo:       newReviewInfo(db, opts...),
-		ReviewReplyInfo:  newReviewReplyInfo(db, opts...),
+		db:                   db,
+		ReviewAppealInfo:     newReviewAppealInfo(db, opts...),
+		ReviewAuditLog:       newReviewAuditLog(db, opts...),
+		ReviewCreationRollup: newReviewCreationRollup(db, opts...),
+		ReviewInfo:           newReviewInfo(db, opts...),
+		ReviewReplyInfo:      newReviewReplyInfo(db, opts...),
+		ReviewTagInfo:        newReviewTagInfo(db, opts...),
+		ReviewTagMap:         newReviewTagMap(db, opts...),
+		ReviewWithdrawalInfo: newReviewWithdrawalInfo(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	ReviewAppealInfo reviewAppealInfo
-	ReviewInfo       reviewInfo
-	ReviewReplyInfo  reviewReplyInfo
+	ReviewAppealInfo     reviewAppealInfo
+	ReviewAuditLog       reviewAuditLog
+	ReviewCreationRollup reviewCreationRollup
+	ReviewInfo           reviewInfo
+	ReviewReplyInfo      reviewReplyInfo
+	ReviewTagInfo        reviewTagInfo
+	ReviewTagMap         reviewTagMap
+	ReviewWithdrawalInfo reviewWithdrawalInfo
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:               db,
-		ReviewAppealInfo: q.ReviewAppealInfo.clone(db),
-		ReviewInfo:       q.ReviewInfo.clone(db),
-		ReviewReplyInfo:  q.ReviewReplyInfo.clone(db),
+		db:                   db,
+		ReviewAppealInfo:     q.ReviewAppealInfo.clone(db),
+		ReviewAuditLog:       q.ReviewAuditLog.clone(db),
+		ReviewCreationRollup: q.ReviewCreationRollup.clone(db),
+		ReviewInfo:           q.ReviewInfo.clone(db),
+		ReviewReplyInfo:      q.ReviewReplyInfo.clone(db),
+		ReviewTagInfo:        q.ReviewTagInfo.clone(db),
+		ReviewTagMap:         q.ReviewTagMap.clone(db),
+		ReviewWithdrawalInfo: q.ReviewWithdrawalInfo.clone(db),
 	}
 }
 
@@ -67,24 +92,39 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:               db,
-		ReviewAppealInfo: q.ReviewAppealInfo.replaceDB(db),
-		ReviewInfo:       q.ReviewInfo.replaceDB(db),
-		ReviewReplyInfo:  q.ReviewReplyInfo.replaceDB(db),
+		db:                   db,
+		ReviewAppealInfo:     q.ReviewAppealInfo.replaceDB(db),
+		ReviewAuditLog:       q.ReviewAuditLog.replaceDB(db),
+		ReviewCreationRollup: q.ReviewCreationRollup.replaceDB(db),
+		ReviewInfo:           q.ReviewInfo.replaceDB(db),
+		ReviewReplyInfo:      q.ReviewReplyInfo.replaceDB(db),
+		ReviewTagInfo:        q.ReviewTagInfo.replaceDB(db),
+		ReviewTagMap:         q.ReviewTagMap.replaceDB(db),
+		ReviewWithdrawalInfo: q.ReviewWithdrawalInfo.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	ReviewAppealInfo IReviewAppealInfoDo
-	ReviewInfo       IReviewInfoDo
-	ReviewReplyInfo  IReviewReplyInfoDo
+	ReviewAppealInfo     IReviewAppealInfoDo
+	ReviewAuditLog       IReviewAuditLogDo
+	ReviewCreationRollup IReviewCreationRollupDo
+	ReviewInfo           IReviewInfoDo
+	ReviewReplyInfo      IReviewReplyInfoDo
+	ReviewTagInfo        IReviewTagInfoDo
+	ReviewTagMap         IReviewTagMapDo
+	ReviewWithdrawalInfo IReviewWithdrawalInfoDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		ReviewAppealInfo: q.ReviewAppealInfo.WithContext(ctx),
-		ReviewInfo:       q.ReviewInfo.WithContext(ctx),
-		ReviewReplyInfo:  q.ReviewReplyInfo.WithContext(ctx),
+		ReviewAppealInfo:     q.ReviewAppealInfo.WithContext(ctx),
+		ReviewAuditLog:       q.ReviewAuditLog.WithContext(ctx),
+		ReviewCreationRollup: q.ReviewCreationRollup.WithContext(ctx),
+		ReviewInfo:           q.ReviewInfo.WithContext(ctx),
+		ReviewReplyInfo:      q.ReviewReplyInfo.WithContext(ctx),
+		ReviewTagInfo:        q.ReviewTagInfo.WithContext(ctx),
+		ReviewTagMap:         q.ReviewTagMap.WithContext(ctx),
+		ReviewWithdrawalInfo: q.ReviewWithdrawalInfo.WithContext(ctx),
 	}
 }
 
@@ -58,6 +58,12 @@ func newReviewInfo(db *gorm.DB, opts ...gen.DOOption) reviewInfo {
 	_reviewInfo.GoodsSnapshoot = field.NewString(tableName, "goods_snapshoot")
 	_reviewInfo.ExtJSON = field.NewString(tableName, "ext_json")
 	_reviewInfo.CtrlJSON = field.NewString(tableName, "ctrl_json")
+	_reviewInfo.ContentHash = field.NewString(tableName, "content_hash")
+	_reviewInfo.TimezoneOffset = field.NewInt32(tableName, "timezone_offset")
+	_reviewInfo.AnomalyScore = field.NewFloat64(tableName, "anomaly_score")
+	_reviewInfo.SchemaVersion = field.NewInt16(tableName, "schema_version")
+	_reviewInfo.IsProbe = field.NewInt32(tableName, "is_probe")
+	_reviewInfo.IsVerifiedPurchase = field.NewInt32(tableName, "is_verified_purchase")
 
 	_reviewInfo.fillFieldMap()
 
@@ -67,38 +73,44 @@ func newReviewInfo(db *gorm.DB, opts ...gen.DOOption) reviewInfo {
 type reviewInfo struct {
 	reviewInfoDo reviewInfoDo
 
-	ALL            field.Asterisk
-	ID             field.Int64  // 主键
-	CreateBy       field.String // 创建方标识
-	UpdateBy       field.String // 更新方标识
-	CreateAt       field.Time   // 创建时间
-	UpdateAt       field.Time   // 更新时间
-	DeleteAt       field.Time   // 逻辑删除标记
-	Version        field.Int32  // 乐观锁标记
-	ReviewID       field.Int64  // 评价id
-	Content        field.String // 评价内容
-	Score          field.Int32  // 评分
-	ServiceScore   field.Int32  // 商家服务评分
-	ExpressScore   field.Int32  // 物流评分
-	HasMedia       field.Int32  // 是否有图或视频
-	OrderID        field.Int64  // 订单id
-	SkuID          field.Int64  // sku id
-	SpuID          field.Int64  // spu id
-	StoreID        field.Int64  // 店铺id
-	UserID         field.Int64  // 用户id
-	Anonymous      field.Int32  // 是否匿名
-	Tags           field.String // 标签json
-	PicInfo        field.String // 媒体信息：图片
-	VideoInfo      field.String // 媒体信息：视频
-	Status         field.Int32  // 状态:10待审核；20审核通过；30审核不通过；40隐藏
-	IsDefault      field.Int32  // 是否默认评价
-	HasReply       field.Int32  // 是否有商家回复:0无;1有
-	OpReason       field.String // 运营审核拒绝原因
-	OpRemarks      field.String // 运营备注
-	OpUser         field.String // 运营者标识
-	GoodsSnapshoot field.String // 商品快照信息
-	ExtJSON        field.String // 信息扩展
-	CtrlJSON       field.String // 控制扩展
+	ALL                field.Asterisk
+	ID                 field.Int64   // 主键
+	CreateBy           field.String  // 创建方标识
+	UpdateBy           field.String  // 更新方标识
+	CreateAt           field.Time    // 创建时间
+	UpdateAt           field.Time    // 更新时间
+	DeleteAt           field.Time    // 逻辑删除标记
+	Version            field.Int32   // 乐观锁标记
+	ReviewID           field.Int64   // 评价id
+	Content            field.String  // 评价内容
+	Score              field.Int32   // 评分
+	ServiceScore       field.Int32   // 商家服务评分
+	ExpressScore       field.Int32   // 物流评分
+	HasMedia           field.Int32   // 是否有图或视频
+	OrderID            field.Int64   // 订单id
+	SkuID              field.Int64   // sku id
+	SpuID              field.Int64   // spu id
+	StoreID            field.Int64   // 店铺id
+	UserID             field.Int64   // 用户id
+	Anonymous          field.Int32   // 是否匿名
+	Tags               field.String  // 标签json
+	PicInfo            field.String  // 媒体信息：图片
+	VideoInfo          field.String  // 媒体信息：视频
+	Status             field.Int32   // 状态:10待审核；20审核通过；30审核不通过；40隐藏；50撤回审核中；60已撤回
+	IsDefault          field.Int32   // 是否默认评价
+	HasReply           field.Int32   // 是否有商家回复:0无;1有
+	OpReason           field.String  // 运营审核拒绝原因
+	OpRemarks          field.String  // 运营备注
+	OpUser             field.String  // 运营者标识
+	GoodsSnapshoot     field.String  // 商品快照信息
+	ExtJSON            field.String  // 信息扩展
+	CtrlJSON           field.String  // 控制扩展
+	ContentHash        field.String  // 内容哈希:sha256(spu_id:小写去空格的内容)，允许重复内容时为NULL
+	TimezoneOffset     field.Int32   // 评价者时区:相对UTC的分钟数
+	AnomalyScore       field.Float64 // 异常分数:孤立森林打分，取值[0,1]
+	SchemaVersion      field.Int16   // 结构版本
+	IsProbe            field.Int32   // 是否复制延迟探测行:0否;1是
+	IsVerifiedPurchase field.Int32   // 是否验证购买:0否;1是，确认下单用户与评价用户一致后写入
 
 	fieldMap map[string]field.Expr
 }
@@ -146,6 +158,12 @@ func (r *reviewInfo) updateTableName(table string) *reviewInfo {
 	r.GoodsSnapshoot = field.NewString(table, "goods_snapshoot")
 	r.ExtJSON = field.NewString(table, "ext_json")
 	r.CtrlJSON = field.NewString(table, "ctrl_json")
+	r.ContentHash = field.NewString(table, "content_hash")
+	r.TimezoneOffset = field.NewInt32(table, "timezone_offset")
+	r.AnomalyScore = field.NewFloat64(table, "anomaly_score")
+	r.SchemaVersion = field.NewInt16(table, "schema_version")
+	r.IsProbe = field.NewInt32(table, "is_probe")
+	r.IsVerifiedPurchase = field.NewInt32(table, "is_verified_purchase")
 
 	r.fillFieldMap()
 
@@ -172,7 +190,7 @@ func (r *reviewInfo) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (r *reviewInfo) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 31)
+	r.fieldMap = make(map[string]field.Expr, 37)
 	r.fieldMap["id"] = r.ID
 	r.fieldMap["create_by"] = r.CreateBy
 	r.fieldMap["update_by"] = r.UpdateBy
@@ -204,6 +222,12 @@ func (r *reviewInfo) fillFieldMap() {
 	r.fieldMap["goods_snapshoot"] = r.GoodsSnapshoot
 	r.fieldMap["ext_json"] = r.ExtJSON
 	r.fieldMap["ctrl_json"] = r.CtrlJSON
+	r.fieldMap["content_hash"] = r.ContentHash
+	r.fieldMap["timezone_offset"] = r.TimezoneOffset
+	r.fieldMap["anomaly_score"] = r.AnomalyScore
+	r.fieldMap["schema_version"] = r.SchemaVersion
+	r.fieldMap["is_probe"] = r.IsProbe
+	r.fieldMap["is_verified_purchase"] = r.IsVerifiedPurchase
 }
 
 func (r reviewInfo) clone(db *gorm.DB) reviewInfo {
@@ -0,0 +1,129 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"review-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const platformReportKeyPrefix = "review:report:platform:"
+
+type reportRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewReportRepo .
+func NewReportRepo(data *Data, logger log.Logger) biz.ReportRepo {
+	return &reportRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *reportRepo) CountReviews(ctx context.Context, from, to time.Time) (int64, error) {
+	ri := r.data.query.ReviewInfo
+	return ri.WithContext(ctx).
+		Where(ri.CreateAt.Gte(from), ri.CreateAt.Lt(to), ri.Status.Neq(biz.ReviewStatusWithdrawn)).
+		Count()
+}
+
+func (r *reportRepo) AvgScore(ctx context.Context, from, to time.Time) (float64, error) {
+	ri := r.data.query.ReviewInfo
+	// 没有评价时AVG为NULL
+	var avg sql.NullFloat64
+	err := ri.WithContext(ctx).
+		Select(ri.Score.Avg()).
+		Where(ri.CreateAt.Gte(from), ri.CreateAt.Lt(to), ri.Status.Neq(biz.ReviewStatusWithdrawn)).
+		Scan(&avg)
+	return avg.Float64, err
+}
+
+func (r *reportRepo) CountAudited(ctx context.Context, from, to time.Time) (approved, rejected int64, err error) {
+	ri := r.data.query.ReviewInfo
+	var counts []struct {
+		Status int32
+		Count  int64
+	}
+	err = ri.WithContext(ctx).
+		Select(ri.Status, ri.ID.Count().As("count")).
+		Where(ri.CreateAt.Gte(from), ri.CreateAt.Lt(to), ri.Status.In(biz.ReviewStatusApproved, biz.ReviewStatusRejected)).
+		Group(ri.Status).
+		Scan(&counts)
+	for _, c := range counts {
+		switch c.Status {
+		case biz.ReviewStatusApproved:
+			approved = c.Count
+		case biz.ReviewStatusRejected:
+			rejected = c.Count
+		}
+	}
+	return approved, rejected, err
+}
+
+func (r *reportRepo) MostActiveStore(ctx context.Context, from, to time.Time) (int64, error) {
+	ri := r.data.query.ReviewInfo
+	var counts []*biz.StoreReviewCount
+	err := ri.WithContext(ctx).
+		Select(ri.StoreID, ri.ID.Count().As("review_count")).
+		Where(ri.CreateAt.Gte(from), ri.CreateAt.Lt(to), ri.Status.Neq(biz.ReviewStatusWithdrawn)).
+		Group(ri.StoreID).
+		Order(ri.ID.Count().Desc()).
+		Limit(1).
+		Scan(&counts)
+	if err != nil || len(counts) == 0 {
+		return 0, err
+	}
+	return counts[0].StoreID, nil
+}
+
+func (r *reportRepo) TopKeywords(ctx context.Context, from, to time.Time, n int) ([]string, error) {
+	return topKeywords(ctx, r.data.rdb, from, to, n)
+}
+
+func (r *reportRepo) DailyReviewers(ctx context.Context, from, to time.Time) ([]int64, error) {
+	var daily []int64
+	err := r.data.query.ReviewInfo.
+		WithContext(ctx).
+		UnderlyingDB().
+		Select("COUNT(DISTINCT user_id)").
+		Where("create_at >= ? AND create_at < ? AND status <> ?", from, to, biz.ReviewStatusWithdrawn).
+		Group("DATE(create_at)").
+		Scan(&daily).Error
+	return daily, err
+}
+
+func platformReportKey(from, to time.Time) string {
+	return fmt.Sprintf("%s%d:%d", platformReportKeyPrefix, from.Unix(), to.Unix())
+}
+
+func (r *reportRepo) GetCachedPlatformReport(ctx context.Context, from, to time.Time) (*biz.PlatformReport, error) {
+	b, err := r.data.rdb.Get(ctx, platformReportKey(from, to)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	report := new(biz.PlatformReport)
+	if err := json.Unmarshal(b, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (r *reportRepo) SavePlatformReport(ctx context.Context, from, to time.Time, report *biz.PlatformReport, ttl time.Duration) error {
+	b, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	return r.data.rdb.Set(ctx, platformReportKey(from, to), b, ttl).Err()
+}
@@ -2,12 +2,18 @@ package data
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"review-service/internal/biz"
+	"review-service/internal/conf"
 	"review-service/internal/data/model"
 	"review-service/internal/data/query"
+	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"gorm.io/gorm/clause"
 )
 
 type reviewRepo struct {
@@ -15,19 +21,38 @@ type reviewRepo struct {
 	log  *log.Helper
 }
 
-// NewReviewRepo .
-func NewReviewRepo(data *Data, logger log.Logger) biz.ReviewRepo {
-	return &reviewRepo{
-		data: data,
-		log:  log.NewHelper(logger),
+// NewReviewRepo 数据库访问外面包一层写穿透缓存
+// 配置了shard_sources时按store_id分库访问，否则所有请求都落到主库
+func NewReviewRepo(cfg *conf.Data, data *Data, logger log.Logger) (biz.ReviewRepo, func(), error) {
+	if len(cfg.Database.GetShardSources()) == 0 {
+		repo := &reviewRepo{
+			data: data,
+			log:  log.NewHelper(logger),
+		}
+		return NewWriteThroughReviewRepo(repo, data.rdb, logger), func() {}, nil
+	}
+	sharded, cleanup, err := NewShardedReviewRepo(cfg, data, logger)
+	if err != nil {
+		return nil, nil, err
 	}
+	return NewWriteThroughReviewRepo(sharded, data.rdb, logger), cleanup, nil
 }
 
 func (r *reviewRepo) SaveReview(ctx context.Context, review *model.ReviewInfo) (*model.ReviewInfo, error) {
 	err := r.data.query.ReviewInfo.
 		WithContext(ctx).
 		Save(review)
-	return review, err
+	if isDuplicateKey(err, "uk_content_hash") {
+		return nil, biz.ErrDuplicateContent
+	}
+	if err != nil {
+		return nil, err
+	}
+	// 关键词索引只用于统计，写入失败不影响评价创建
+	if err := indexReviewKeywords(ctx, r.data.rdb, review); err != nil {
+		r.log.WithContext(ctx).Warnf("indexReviewKeywords fail, reviewID:%v err:%v", review.ReviewID, err)
+	}
+	return review, nil
 }
 
 // GetReviewByOrderID 根据订单ID查询评价
@@ -95,8 +120,26 @@ func (r *reviewRepo) GetReviewReply(ctx context.Context, reviewID int64) (*model
 		Where(r.data.query.ReviewReplyInfo.ReviewID.Eq(reviewID)).
 		First()
 }
-func (r *reviewRepo) AuditReview(context.Context, *biz.AuditParam) error {
-	// 待补充
+
+// AuditReview 更新评价的审核结果，撤回中和已撤回的评价不能审核
+func (r *reviewRepo) AuditReview(ctx context.Context, param *biz.AuditParam) error {
+	ri := r.data.query.ReviewInfo
+	info, err := ri.WithContext(ctx).
+		Where(ri.ReviewID.Eq(param.ReviewID),
+			ri.Status.In(reviewStatusPending, biz.ReviewStatusApproved, biz.ReviewStatusRejected)).
+		Updates(map[string]interface{}{
+			"status":     param.Status,
+			"op_user":    param.OpUser,
+			"op_reason":  param.OpReason,
+			"op_remarks": param.OpRemarks,
+		})
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("AuditReview update review fail, err:%v", err)
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return biz.ErrStatusChanged
+	}
 	return nil
 }
 
@@ -113,8 +156,213 @@ func (r *reviewRepo) ListReviewByUserID(ctx context.Context, userID int64, offse
 	return r.data.query.ReviewInfo.
 		WithContext(ctx).
 		Where(r.data.query.ReviewInfo.UserID.Eq(userID)).
+		Where(r.data.query.ReviewInfo.Status.Neq(biz.ReviewStatusWithdrawn)).
 		Order(r.data.query.ReviewInfo.ID.Desc()).
 		Limit(limit).
 		Offset(offset).
 		Find()
 }
+
+// ListReviewByStoreID 分页查询店铺的评价，status为0时不限制状态
+func (r *reviewRepo) ListReviewByStoreID(ctx context.Context, storeID int64, status int32, offset, limit int) ([]*model.ReviewInfo, error) {
+	ri := r.data.query.ReviewInfo
+	q := ri.WithContext(ctx).Where(ri.StoreID.Eq(storeID))
+	if status > 0 {
+		q = q.Where(ri.Status.Eq(status))
+	}
+	return q.Order(ri.ID.Desc()).
+		Limit(limit).
+		Offset(offset).
+		Find()
+}
+
+// GetReviewTag 根据标签ID查询标签
+func (r *reviewRepo) GetReviewTag(ctx context.Context, tagID int64) (*model.ReviewTagInfo, error) {
+	return r.data.query.ReviewTagInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewTagInfo.TagID.Eq(tagID)).
+		First()
+}
+
+// ListReviewByFilter 按评价ID顺序分批查询符合条件的评价
+// 分库后各分片的自增主键会重复，用uk_review_id上的评价ID分页
+func (r *reviewRepo) ListReviewByFilter(ctx context.Context, filter *biz.BulkFilter, afterID int64, limit int) ([]*model.ReviewInfo, error) {
+	ri := r.data.query.ReviewInfo
+	q := ri.WithContext(ctx).
+		Select(ri.ID, ri.ReviewID).
+		Where(ri.ReviewID.Gt(afterID))
+	if filter.StoreID > 0 {
+		q = q.Where(ri.StoreID.Eq(filter.StoreID))
+	}
+	if filter.Status > 0 {
+		q = q.Where(ri.Status.Eq(filter.Status))
+	}
+	if filter.MinScore > 0 {
+		q = q.Where(ri.Score.Gte(filter.MinScore))
+	}
+	if filter.MaxScore > 0 {
+		q = q.Where(ri.Score.Lte(filter.MaxScore))
+	}
+	return q.Order(ri.ReviewID).Limit(limit).Find()
+}
+
+// SaveReviewTagMaps 批量写入评价标签关联，已存在的关联会被忽略，返回新增的行数
+func (r *reviewRepo) SaveReviewTagMaps(ctx context.Context, maps []*model.ReviewTagMap) (int64, error) {
+	// INSERT IGNORE 依赖 uk_review_tag 唯一索引跳过已打过标签的评价
+	ret := r.data.query.ReviewTagMap.
+		WithContext(ctx).
+		UnderlyingDB().
+		Clauses(clause.Insert{Modifier: "IGNORE"}).
+		Create(maps)
+	return ret.RowsAffected, ret.Error
+}
+
+// SaveAuditLog 写入运营操作日志
+func (r *reviewRepo) SaveAuditLog(ctx context.Context, auditLog *model.ReviewAuditLog) error {
+	return r.data.query.ReviewAuditLog.
+		WithContext(ctx).
+		Create(auditLog)
+}
+
+func (r *reviewRepo) GetWithdrawal(ctx context.Context, withdrawalID int64) (*model.ReviewWithdrawalInfo, error) {
+	return r.data.query.ReviewWithdrawalInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewWithdrawalInfo.WithdrawalID.Eq(withdrawalID)).
+		First()
+}
+
+func (r *reviewRepo) GetWithdrawalByReviewID(ctx context.Context, reviewID int64) ([]*model.ReviewWithdrawalInfo, error) {
+	return r.data.query.ReviewWithdrawalInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewWithdrawalInfo.ReviewID.Eq(reviewID)).
+		Find()
+}
+
+// SaveWithdrawal 创建撤回申请，并把审核通过的评价置为撤回审核中
+func (r *reviewRepo) SaveWithdrawal(ctx context.Context, withdrawal *model.ReviewWithdrawalInfo) error {
+	return r.data.query.Transaction(func(tx *query.Query) error {
+		// 只有审核通过的评价可以申请撤回，条件更新防止并发修改状态
+		info, err := tx.ReviewInfo.
+			WithContext(ctx).
+			Where(tx.ReviewInfo.ReviewID.Eq(withdrawal.ReviewID), tx.ReviewInfo.Status.Eq(biz.ReviewStatusApproved)).
+			Update(tx.ReviewInfo.Status, biz.ReviewStatusWithdrawalPending)
+		if err != nil {
+			r.log.WithContext(ctx).Errorf("SaveWithdrawal update review fail, err:%v", err)
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return biz.ErrStatusChanged
+		}
+		if err := tx.ReviewWithdrawalInfo.
+			WithContext(ctx).
+			Create(withdrawal); err != nil {
+			r.log.WithContext(ctx).Errorf("SaveWithdrawal create withdrawal fail, err:%v", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// AuditWithdrawal 更新撤回申请的审核结果，并把评价状态改为reviewStatus
+func (r *reviewRepo) AuditWithdrawal(ctx context.Context, withdrawal *model.ReviewWithdrawalInfo, reviewStatus int32) error {
+	return r.data.query.Transaction(func(tx *query.Query) error {
+		info, err := tx.ReviewWithdrawalInfo.
+			WithContext(ctx).
+			Where(tx.ReviewWithdrawalInfo.WithdrawalID.Eq(withdrawal.WithdrawalID),
+				tx.ReviewWithdrawalInfo.Status.Eq(biz.WithdrawalStatusPending)).
+			Updates(map[string]interface{}{
+				"status":     withdrawal.Status,
+				"op_user":    withdrawal.OpUser,
+				"op_remarks": withdrawal.OpRemarks,
+			})
+		if err != nil {
+			r.log.WithContext(ctx).Errorf("AuditWithdrawal update withdrawal fail, err:%v", err)
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return biz.ErrStatusChanged
+		}
+		if _, err := tx.ReviewInfo.
+			WithContext(ctx).
+			Where(tx.ReviewInfo.ReviewID.Eq(withdrawal.ReviewID), tx.ReviewInfo.Status.Eq(biz.ReviewStatusWithdrawalPending)).
+			Update(tx.ReviewInfo.Status, reviewStatus); err != nil {
+			r.log.WithContext(ctx).Errorf("AuditWithdrawal update review fail, err:%v", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// GetProductStats 一条SQL按商品聚合审核通过的评价，没有评价的商品不会返回
+func (r *reviewRepo) GetProductStats(ctx context.Context, spuIDs []int64) ([]*biz.ProductStatSummary, error) {
+	ri := r.data.query.ReviewInfo
+	var stats []*biz.ProductStatSummary
+	err := ri.WithContext(ctx).
+		UnderlyingDB().
+		Select("spu_id AS product_id, AVG(score) AS avg_score, COUNT(*) AS review_count, MAX(is_verified_purchase) AS has_verified_purchase").
+		Where("spu_id IN ? AND status = ?", spuIDs, biz.ReviewStatusApproved).
+		Group("spu_id").
+		Scan(&stats).Error
+	return stats, err
+}
+
+// ListRecentReviews 最近创建的评价，只查询异常检测用到的字段
+func (r *reviewRepo) ListRecentReviews(ctx context.Context, limit int) ([]*model.ReviewInfo, error) {
+	ri := r.data.query.ReviewInfo
+	return ri.WithContext(ctx).
+		Select(ri.ID, ri.Score, ri.Content, ri.PicInfo, ri.VideoInfo, ri.CreateAt).
+		Order(ri.ID.Desc()).
+		Limit(limit).
+		Find()
+}
+
+// ListAnomalousReviews 店铺下异常分数不低于threshold的评价，已撤回的评价除外
+func (r *reviewRepo) ListAnomalousReviews(ctx context.Context, storeID int64, threshold float64, limit int) ([]*model.ReviewInfo, error) {
+	ri := r.data.query.ReviewInfo
+	return ri.WithContext(ctx).
+		Where(ri.StoreID.Eq(storeID), ri.AnomalyScore.Gte(threshold), ri.Status.Neq(biz.ReviewStatusWithdrawn)).
+		Order(ri.AnomalyScore.Desc()).
+		Limit(limit).
+		Find()
+}
+
+// GetPeriodStats 店铺在[from, to)内创建的评价的平均分和数量
+func (r *reviewRepo) GetPeriodStats(ctx context.Context, storeID int64, from, to time.Time) (*biz.PeriodStats, error) {
+	ri := r.data.query.ReviewInfo
+	var row struct {
+		// 没有评价时AVG为NULL
+		AvgScore    sql.NullFloat64
+		ReviewCount int64
+	}
+	err := ri.WithContext(ctx).
+		Select(ri.Score.Avg().As("avg_score"), ri.ID.Count().As("review_count")).
+		Where(ri.StoreID.Eq(storeID), ri.CreateAt.Gte(from), ri.CreateAt.Lt(to), ri.Status.Neq(biz.ReviewStatusWithdrawn)).
+		Scan(&row)
+	if err != nil {
+		return nil, err
+	}
+	return &biz.PeriodStats{AvgScore: row.AvgScore.Float64, ReviewCount: row.ReviewCount}, nil
+}
+
+// ListAllReviewByUserID 用户的全部评价，包括已撤回的
+func (r *reviewRepo) ListAllReviewByUserID(ctx context.Context, userID int64) ([]*model.ReviewInfo, error) {
+	ri := r.data.query.ReviewInfo
+	return ri.WithContext(ctx).
+		Where(ri.UserID.Eq(userID)).
+		Order(ri.ID).
+		Find()
+}
+
+func (r *reviewRepo) ListWithdrawalByUserID(ctx context.Context, userID int64) ([]*model.ReviewWithdrawalInfo, error) {
+	rw := r.data.query.ReviewWithdrawalInfo
+	return rw.WithContext(ctx).
+		Where(rw.UserID.Eq(userID)).
+		Order(rw.ID).
+		Find()
+}
+
+// isDuplicateKey 判断err是否为MySQL唯一索引key冲突（1062错误）
+func isDuplicateKey(err error, key string) bool {
+	var mysqlErr *mysqldriver.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 && strings.Contains(mysqlErr.Message, key)
+}
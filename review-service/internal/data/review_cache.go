@@ -0,0 +1,190 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"review-service/pkg/metrics"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	reviewCacheKeyPrefix = "review:"
+	// reviewCacheTTL 评价缓存有效期
+	reviewCacheTTL = 10 * time.Minute
+	// reviewStatusPending 待审核，与review_info.status的默认值一致
+	reviewStatusPending = 10
+)
+
+// WriteThroughReviewRepo 写穿透缓存：创建评价写库成功后把评价写入Redis，紧接着的读请求不用再查库
+// 修改评价的写操作在写库前后各删除一次缓存，下次读时回源：
+// 写库前删除让写库期间的读请求回源，写库后再删除一次，清掉写库期间回源读到旧数据回填的缓存；
+// 删除缓存时同时增加版本号，删除之后才完成回源的读请求不会再把旧数据写回缓存
+// 缓存读写失败只记日志，不影响数据库操作
+type WriteThroughReviewRepo struct {
+	biz.ReviewRepo
+	rdb *redis.Client
+	log *log.Helper
+}
+
+// NewWriteThroughReviewRepo .
+func NewWriteThroughReviewRepo(repo biz.ReviewRepo, rdb *redis.Client, logger log.Logger) *WriteThroughReviewRepo {
+	return &WriteThroughReviewRepo{
+		ReviewRepo: repo,
+		rdb:        rdb,
+		log:        log.NewHelper(logger),
+	}
+}
+
+func reviewCacheKey(reviewID int64) string {
+	return reviewCacheKeyPrefix + strconv.FormatInt(reviewID, 10)
+}
+
+// reviewVersionKey 评价缓存的版本号，每次删除缓存时加1
+// 版本号过期后下一次写操作会重新创建，同样能让WATCH它的读请求放弃回填
+func reviewVersionKey(reviewID int64) string {
+	return reviewCacheKeyPrefix + "ver:" + strconv.FormatInt(reviewID, 10)
+}
+
+// SaveReview 写库成功后写缓存
+func (r *WriteThroughReviewRepo) SaveReview(ctx context.Context, review *model.ReviewInfo) (*model.ReviewInfo, error) {
+	// 零值字段写库时由数据库填默认值，但不会回填到结构体里，这里提前填好保证缓存和数据库一致
+	now := time.Now().Truncate(time.Second)
+	if review.CreateAt.IsZero() {
+		review.CreateAt = now
+	}
+	if review.UpdateAt.IsZero() {
+		review.UpdateAt = now
+	}
+	if review.Status == 0 {
+		review.Status = reviewStatusPending
+	}
+	if review.SchemaVersion == 0 {
+		review.SchemaVersion = model.CurrentReviewSchemaVersion
+	}
+	saved, err := r.ReviewRepo.SaveReview(ctx, review)
+	if err != nil {
+		return saved, err
+	}
+	b, err := json.Marshal(saved)
+	if err == nil {
+		err = r.rdb.Set(ctx, reviewCacheKey(saved.ReviewID), b, reviewCacheTTL).Err()
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("WriteThroughReviewRepo cache review fail, reviewID:%v err:%v", saved.ReviewID, err)
+	}
+	return saved, nil
+}
+
+// GetReview 先查缓存，未命中时查库并回填缓存
+func (r *WriteThroughReviewRepo) GetReview(ctx context.Context, reviewID int64) (*model.ReviewInfo, error) {
+	key := reviewCacheKey(reviewID)
+	b, err := r.rdb.Get(ctx, key).Bytes()
+	if err == nil {
+		// 缓存里可能是升级前写入的旧版本数据，和从数据库读出时一样做迁移
+		review := new(model.ReviewInfo)
+		if err = json.Unmarshal(b, review); err == nil {
+			err = review.UpgradeSchema()
+		}
+		if err == nil {
+			metrics.WriteThroughCacheHit()
+			return review, nil
+		}
+	}
+	if !errors.Is(err, redis.Nil) {
+		r.log.WithContext(ctx).Warnf("WriteThroughReviewRepo get cache fail, reviewID:%v err:%v", reviewID, err)
+	}
+	metrics.WriteThroughCacheMiss()
+	// 回源前WATCH评价的版本号，回源期间有写操作时放弃回填，避免旧数据在写操作删除缓存之后写回缓存
+	var (
+		review *model.ReviewInfo
+		dbErr  error
+	)
+	err = r.rdb.Watch(ctx, func(tx *redis.Tx) error {
+		if review, dbErr = r.ReviewRepo.GetReview(ctx, reviewID); dbErr != nil {
+			return nil
+		}
+		b, err := json.Marshal(review)
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, b, reviewCacheTTL)
+			return nil
+		})
+		return err
+	}, reviewVersionKey(reviewID))
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	if review == nil {
+		// Redis不可用时没有执行回源
+		r.log.WithContext(ctx).Warnf("WriteThroughReviewRepo watch fail, reviewID:%v err:%v", reviewID, err)
+		return r.ReviewRepo.GetReview(ctx, reviewID)
+	}
+	if err != nil && !errors.Is(err, redis.TxFailedErr) {
+		r.log.WithContext(ctx).Warnf("WriteThroughReviewRepo fill cache fail, reviewID:%v err:%v", reviewID, err)
+	}
+	return review, nil
+}
+
+// invalidate 删除评价缓存并增加版本号，让正在回源的读请求放弃回填
+func (r *WriteThroughReviewRepo) invalidate(ctx context.Context, reviewIDs ...int64) {
+	pipe := r.rdb.TxPipeline()
+	for _, id := range reviewIDs {
+		pipe.Del(ctx, reviewCacheKey(id))
+		pipe.Incr(ctx, reviewVersionKey(id))
+		pipe.Expire(ctx, reviewVersionKey(id), reviewCacheTTL)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.log.WithContext(ctx).Warnf("WriteThroughReviewRepo invalidate fail, reviewIDs:%v err:%v", reviewIDs, err)
+	}
+}
+
+// invalidateAround 写库前后各删除一次缓存，写库失败时也删除
+func (r *WriteThroughReviewRepo) invalidateAround(ctx context.Context, reviewID int64, write func() error) error {
+	r.invalidate(ctx, reviewID)
+	defer r.invalidate(ctx, reviewID)
+	return write()
+}
+
+// 以下写操作会修改review_info
+
+func (r *WriteThroughReviewRepo) SaveReply(ctx context.Context, reply *model.ReviewReplyInfo) (saved *model.ReviewReplyInfo, err error) {
+	err = r.invalidateAround(ctx, reply.ReviewID, func() error {
+		saved, err = r.ReviewRepo.SaveReply(ctx, reply)
+		return err
+	})
+	return saved, err
+}
+
+func (r *WriteThroughReviewRepo) AuditReview(ctx context.Context, param *biz.AuditParam) error {
+	return r.invalidateAround(ctx, param.ReviewID, func() error {
+		return r.ReviewRepo.AuditReview(ctx, param)
+	})
+}
+
+func (r *WriteThroughReviewRepo) AppealReview(ctx context.Context, param *biz.AppealParam) error {
+	return r.invalidateAround(ctx, param.ReviewID, func() error {
+		return r.ReviewRepo.AppealReview(ctx, param)
+	})
+}
+
+func (r *WriteThroughReviewRepo) SaveWithdrawal(ctx context.Context, withdrawal *model.ReviewWithdrawalInfo) error {
+	return r.invalidateAround(ctx, withdrawal.ReviewID, func() error {
+		return r.ReviewRepo.SaveWithdrawal(ctx, withdrawal)
+	})
+}
+
+func (r *WriteThroughReviewRepo) AuditWithdrawal(ctx context.Context, withdrawal *model.ReviewWithdrawalInfo, reviewStatus int32) error {
+	return r.invalidateAround(ctx, withdrawal.ReviewID, func() error {
+		return r.ReviewRepo.AuditWithdrawal(ctx, withdrawal, reviewStatus)
+	})
+}
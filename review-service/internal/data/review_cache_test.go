@@ -0,0 +1,168 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// fakeDBReviewRepo 代替数据库的ReviewRepo，记录GetReview的调用次数
+// afterRead在GetReview读出数据之后、返回之前调用一次，用来模拟回源期间的并发写
+type fakeDBReviewRepo struct {
+	biz.ReviewRepo
+
+	mu        sync.Mutex
+	reviews   map[int64]model.ReviewInfo
+	getCalls  int
+	afterRead func()
+}
+
+func (r *fakeDBReviewRepo) SaveReview(_ context.Context, review *model.ReviewInfo) (*model.ReviewInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reviews[review.ReviewID] = *review
+	return review, nil
+}
+
+func (r *fakeDBReviewRepo) GetReview(_ context.Context, reviewID int64) (*model.ReviewInfo, error) {
+	r.mu.Lock()
+	r.getCalls++
+	review, ok := r.reviews[reviewID]
+	afterRead := r.afterRead
+	r.afterRead = nil
+	r.mu.Unlock()
+	if afterRead != nil {
+		afterRead()
+	}
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &review, nil
+}
+
+func (r *fakeDBReviewRepo) update(reviewID int64, fn func(review *model.ReviewInfo)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	review := r.reviews[reviewID]
+	fn(&review)
+	r.reviews[reviewID] = review
+}
+
+func (r *fakeDBReviewRepo) AuditReview(_ context.Context, param *biz.AuditParam) error {
+	r.update(param.ReviewID, func(review *model.ReviewInfo) { review.Status = param.Status })
+	return nil
+}
+
+func (r *fakeDBReviewRepo) SaveReply(_ context.Context, reply *model.ReviewReplyInfo) (*model.ReviewReplyInfo, error) {
+	r.update(reply.ReviewID, func(review *model.ReviewInfo) { review.HasReply = 1 })
+	return reply, nil
+}
+
+func (r *fakeDBReviewRepo) AuditWithdrawal(_ context.Context, withdrawal *model.ReviewWithdrawalInfo, reviewStatus int32) error {
+	r.update(withdrawal.ReviewID, func(review *model.ReviewInfo) { review.Status = reviewStatus })
+	return nil
+}
+
+func newTestWriteThroughRepo(t *testing.T) (*WriteThroughReviewRepo, *fakeDBReviewRepo) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	db := &fakeDBReviewRepo{reviews: make(map[int64]model.ReviewInfo)}
+	return NewWriteThroughReviewRepo(db, rdb, log.DefaultLogger), db
+}
+
+// TestWriteThroughFirstReadFromCache 创建后的第一次读不查库
+func TestWriteThroughFirstReadFromCache(t *testing.T) {
+	repo, db := newTestWriteThroughRepo(t)
+	ctx := context.Background()
+	if _, err := repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: 1, StoreID: 2, Content: "good"}); err != nil {
+		t.Fatalf("SaveReview fail, err:%v", err)
+	}
+	got, err := repo.GetReview(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetReview fail, err:%v", err)
+	}
+	if db.getCalls != 0 {
+		t.Fatalf("GetReview queried the db %d times, want 0", db.getCalls)
+	}
+	if got.Content != "good" || got.Status != reviewStatusPending || got.SchemaVersion != model.CurrentReviewSchemaVersion {
+		t.Fatalf("cached review = %+v, want defaults filled", got)
+	}
+}
+
+// TestWriteThroughReadAfterWrite 回源期间有写操作时不回填旧数据，写完后读到新数据
+func TestWriteThroughReadAfterWrite(t *testing.T) {
+	const reviewID = 1
+	tests := []struct {
+		name  string
+		write func(ctx context.Context, repo *WriteThroughReviewRepo) error
+		check func(review *model.ReviewInfo) bool
+	}{
+		{
+			name: "AuditReview",
+			write: func(ctx context.Context, repo *WriteThroughReviewRepo) error {
+				return repo.AuditReview(ctx, &biz.AuditParam{ReviewID: reviewID, Status: biz.ReviewStatusApproved})
+			},
+			check: func(review *model.ReviewInfo) bool { return review.Status == biz.ReviewStatusApproved },
+		},
+		{
+			name: "SaveReply",
+			write: func(ctx context.Context, repo *WriteThroughReviewRepo) error {
+				_, err := repo.SaveReply(ctx, &model.ReviewReplyInfo{ReviewID: reviewID})
+				return err
+			},
+			check: func(review *model.ReviewInfo) bool { return review.HasReply == 1 },
+		},
+		{
+			name: "AuditWithdrawal",
+			write: func(ctx context.Context, repo *WriteThroughReviewRepo) error {
+				return repo.AuditWithdrawal(ctx, &model.ReviewWithdrawalInfo{ReviewID: reviewID}, biz.ReviewStatusWithdrawn)
+			},
+			check: func(review *model.ReviewInfo) bool { return review.Status == biz.ReviewStatusWithdrawn },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, db := newTestWriteThroughRepo(t)
+			ctx := context.Background()
+			// 缓存里没有评价，读请求回源读到旧数据后，写操作完成了写库和删除缓存，读请求才回填缓存
+			db.reviews[reviewID] = model.ReviewInfo{ReviewID: reviewID, Status: reviewStatusPending, SchemaVersion: 1}
+			db.afterRead = func() {
+				if err := tt.write(ctx, repo); err != nil {
+					t.Errorf("write fail, err:%v", err)
+				}
+			}
+			stale, err := repo.GetReview(ctx, reviewID)
+			if err != nil {
+				t.Fatalf("GetReview fail, err:%v", err)
+			}
+			if tt.check(stale) {
+				t.Fatalf("GetReview racing the write already sees the new row")
+			}
+			got, err := repo.GetReview(ctx, reviewID)
+			if err != nil {
+				t.Fatalf("GetReview fail, err:%v", err)
+			}
+			if !tt.check(got) {
+				t.Fatalf("GetReview after the write = %+v, want the written row", got)
+			}
+			// 写后的第一次读回源并回填缓存，之后的读命中缓存
+			calls := db.getCalls
+			if _, err := repo.GetReview(ctx, reviewID); err != nil {
+				t.Fatalf("GetReview fail, err:%v", err)
+			}
+			if db.getCalls != calls {
+				t.Fatalf("GetReview queried the db again, want a cache hit")
+			}
+		})
+	}
+}
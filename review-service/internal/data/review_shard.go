@@ -0,0 +1,429 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"review-service/pkg/shard"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+// ShardedReviewRepo 按store_id分库的评价数据访问，配置了shard_sources时作为biz.ReviewRepo注入
+// 分片下标为 storeID % len(shards)，评价和它的回复、撤回申请、标签关联在同一个分片上
+// 标签和运营操作日志是全局数据，仍然在主库
+// 路由方式：
+//   - 带storeID的请求只访问一个分片
+//   - 只有reviewID的写请求先并发查所有分片找到评价所在的分片，再在该分片上执行
+//   - 按用户、订单等跨店铺的查询并发访问所有分片后合并
+type ShardedReviewRepo struct {
+	primary *reviewRepo
+	shards  []*reviewRepo
+	log     *log.Helper
+}
+
+// NewShardedReviewRepo 打开cfg.Database.shard_sources中的分库连接
+func NewShardedReviewRepo(cfg *conf.Data, data *Data, logger log.Logger) (*ShardedReviewRepo, func(), error) {
+	shardDBs := make([]*gorm.DB, 0, len(cfg.Database.GetShardSources()))
+	closeShards := func() {
+		for _, sdb := range shardDBs {
+			if sqlDB, err := sdb.DB(); err == nil {
+				sqlDB.Close()
+			}
+		}
+	}
+	r := &ShardedReviewRepo{
+		primary: &reviewRepo{data: data, log: log.NewHelper(logger)},
+		log:     log.NewHelper(logger),
+	}
+	for _, source := range cfg.Database.GetShardSources() {
+		sdb, err := openDB(cfg.Database.GetDriver(), source)
+		if err != nil {
+			closeShards()
+			return nil, nil, err
+		}
+		shardDBs = append(shardDBs, sdb)
+		r.shards = append(r.shards, &reviewRepo{
+			data: &Data{query: query.Use(sdb), rdb: data.rdb, log: data.log},
+			log:  log.NewHelper(logger),
+		})
+	}
+	cleanup := func() {
+		r.log.Info("closing the review shards")
+		closeShards()
+	}
+	return r, cleanup, nil
+}
+
+// allShards 所有分片，未配置分库时只有主库
+func (r *ShardedReviewRepo) allShards() []*reviewRepo {
+	if len(r.shards) == 0 {
+		return []*reviewRepo{r.primary}
+	}
+	return r.shards
+}
+
+// shardFor 根据storeID选择分片
+func (r *ShardedReviewRepo) shardFor(storeID int64) *reviewRepo {
+	shards := r.allShards()
+	return shards[shard.Resolve(storeID, len(shards))]
+}
+
+// fanOut 在所有分片上并发执行fn，fn的调用之间需要自行加锁合并结果
+func (r *ShardedReviewRepo) fanOut(ctx context.Context, fn func(ctx context.Context, s *reviewRepo) error) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for _, s := range r.allShards() {
+		s := s
+		g.Go(func() error {
+			if err := fn(ctx, s); err != nil {
+				r.log.WithContext(ctx).Errorf("query shard fail, err:%v", err)
+				return err
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// fanOutList 在所有分片上执行查询并合并结果
+func fanOutList[T any](ctx context.Context, r *ShardedReviewRepo, fn func(ctx context.Context, s *reviewRepo) ([]T, error)) ([]T, error) {
+	var (
+		mu   sync.Mutex
+		list []T
+	)
+	err := r.fanOut(ctx, func(ctx context.Context, s *reviewRepo) error {
+		items, err := fn(ctx, s)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		list = append(list, items...)
+		mu.Unlock()
+		return nil
+	})
+	return list, err
+}
+
+// locate 找到评价所在的分片，评价不存在时返回gorm.ErrRecordNotFound
+func (r *ShardedReviewRepo) locate(ctx context.Context, reviewID int64) (*reviewRepo, *model.ReviewInfo, error) {
+	var (
+		mu     sync.Mutex
+		owner  *reviewRepo
+		review *model.ReviewInfo
+	)
+	err := r.fanOut(ctx, func(ctx context.Context, s *reviewRepo) error {
+		found, err := s.GetReview(ctx, reviewID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		owner, review = s, found
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if owner == nil {
+		return nil, nil, gorm.ErrRecordNotFound
+	}
+	return owner, review, nil
+}
+
+// sortByReviewIDDesc 各分片的自增主键互不相关，合并时按雪花算法生成的评价ID排序，评价ID越大创建越晚
+func sortByReviewIDDesc(list []*model.ReviewInfo) {
+	sort.Slice(list, func(i, j int) bool { return list[i].ReviewID > list[j].ReviewID })
+}
+
+// SaveReview 写入评价所属店铺的分片
+func (r *ShardedReviewRepo) SaveReview(ctx context.Context, review *model.ReviewInfo) (*model.ReviewInfo, error) {
+	return r.shardFor(review.StoreID).SaveReview(ctx, review)
+}
+
+func (r *ShardedReviewRepo) GetReviewByOrderID(ctx context.Context, orderID int64) ([]*model.ReviewInfo, error) {
+	return fanOutList(ctx, r, func(ctx context.Context, s *reviewRepo) ([]*model.ReviewInfo, error) {
+		return s.GetReviewByOrderID(ctx, orderID)
+	})
+}
+
+func (r *ShardedReviewRepo) GetReview(ctx context.Context, reviewID int64) (*model.ReviewInfo, error) {
+	_, review, err := r.locate(ctx, reviewID)
+	return review, err
+}
+
+// SaveReply 回复的storeID就是评价的storeID，写到评价所在的分片
+func (r *ShardedReviewRepo) SaveReply(ctx context.Context, reply *model.ReviewReplyInfo) (*model.ReviewReplyInfo, error) {
+	return r.shardFor(reply.StoreID).SaveReply(ctx, reply)
+}
+
+func (r *ShardedReviewRepo) GetReviewReply(ctx context.Context, reviewID int64) (*model.ReviewReplyInfo, error) {
+	s, _, err := r.locate(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetReviewReply(ctx, reviewID)
+}
+
+func (r *ShardedReviewRepo) AuditReview(ctx context.Context, param *biz.AuditParam) error {
+	s, _, err := r.locate(ctx, param.ReviewID)
+	if err != nil {
+		return err
+	}
+	return s.AuditReview(ctx, param)
+}
+
+func (r *ShardedReviewRepo) AppealReview(ctx context.Context, param *biz.AppealParam) error {
+	return r.shardFor(param.StoreID).AppealReview(ctx, param)
+}
+
+// AuditAppeal 申诉只有申诉ID，在每个分片上执行，只有申诉所在的分片会更新
+func (r *ShardedReviewRepo) AuditAppeal(ctx context.Context, param *biz.AuditAppealParam) error {
+	return r.fanOut(ctx, func(ctx context.Context, s *reviewRepo) error {
+		return s.AuditAppeal(ctx, param)
+	})
+}
+
+// ListReviewByUserID 每个分片取前offset+limit条，合并排序后再分页
+func (r *ShardedReviewRepo) ListReviewByUserID(ctx context.Context, userID int64, offset, limit int) ([]*model.ReviewInfo, error) {
+	list, err := fanOutList(ctx, r, func(ctx context.Context, s *reviewRepo) ([]*model.ReviewInfo, error) {
+		return s.ListReviewByUserID(ctx, userID, 0, offset+limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortByReviewIDDesc(list)
+	return page(list, offset, limit), nil
+}
+
+func (r *ShardedReviewRepo) ListReviewByStoreID(ctx context.Context, storeID int64, status int32, offset, limit int) ([]*model.ReviewInfo, error) {
+	return r.shardFor(storeID).ListReviewByStoreID(ctx, storeID, status, offset, limit)
+}
+
+// GetReviewTag 标签在主库
+func (r *ShardedReviewRepo) GetReviewTag(ctx context.Context, tagID int64) (*model.ReviewTagInfo, error) {
+	return r.primary.GetReviewTag(ctx, tagID)
+}
+
+// ListReviewByFilter 指定店铺时只查一个分片，否则每个分片取limit条，合并后按评价ID取前limit条
+func (r *ShardedReviewRepo) ListReviewByFilter(ctx context.Context, filter *biz.BulkFilter, afterID int64, limit int) ([]*model.ReviewInfo, error) {
+	if filter.StoreID > 0 {
+		return r.shardFor(filter.StoreID).ListReviewByFilter(ctx, filter, afterID, limit)
+	}
+	list, err := fanOutList(ctx, r, func(ctx context.Context, s *reviewRepo) ([]*model.ReviewInfo, error) {
+		return s.ListReviewByFilter(ctx, filter, afterID, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ReviewID < list[j].ReviewID })
+	return page(list, 0, limit), nil
+}
+
+// SaveReviewTagMaps 标签关联写到评价所在的分片，先在每个分片上查出属于该分片的评价
+func (r *ShardedReviewRepo) SaveReviewTagMaps(ctx context.Context, maps []*model.ReviewTagMap) (int64, error) {
+	reviewIDs := make([]int64, 0, len(maps))
+	for _, m := range maps {
+		reviewIDs = append(reviewIDs, m.ReviewID)
+	}
+	var (
+		mu    sync.Mutex
+		total int64
+	)
+	err := r.fanOut(ctx, func(ctx context.Context, s *reviewRepo) error {
+		ri := s.data.query.ReviewInfo
+		var owned []int64
+		if err := ri.WithContext(ctx).Where(ri.ReviewID.In(reviewIDs...)).Pluck(ri.ReviewID, &owned); err != nil {
+			return err
+		}
+		if len(owned) == 0 {
+			return nil
+		}
+		ownedSet := make(map[int64]struct{}, len(owned))
+		for _, id := range owned {
+			ownedSet[id] = struct{}{}
+		}
+		shardMaps := make([]*model.ReviewTagMap, 0, len(owned))
+		for _, m := range maps {
+			if _, ok := ownedSet[m.ReviewID]; ok {
+				shardMaps = append(shardMaps, m)
+			}
+		}
+		n, err := s.SaveReviewTagMaps(ctx, shardMaps)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		total += n
+		mu.Unlock()
+		return nil
+	})
+	return total, err
+}
+
+// SaveAuditLog 运营操作日志在主库
+func (r *ShardedReviewRepo) SaveAuditLog(ctx context.Context, auditLog *model.ReviewAuditLog) error {
+	return r.primary.SaveAuditLog(ctx, auditLog)
+}
+
+func (r *ShardedReviewRepo) GetWithdrawal(ctx context.Context, withdrawalID int64) (*model.ReviewWithdrawalInfo, error) {
+	list, err := fanOutList(ctx, r, func(ctx context.Context, s *reviewRepo) ([]*model.ReviewWithdrawalInfo, error) {
+		withdrawal, err := s.GetWithdrawal(ctx, withdrawalID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []*model.ReviewWithdrawalInfo{withdrawal}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return list[0], nil
+}
+
+func (r *ShardedReviewRepo) GetWithdrawalByReviewID(ctx context.Context, reviewID int64) ([]*model.ReviewWithdrawalInfo, error) {
+	return fanOutList(ctx, r, func(ctx context.Context, s *reviewRepo) ([]*model.ReviewWithdrawalInfo, error) {
+		return s.GetWithdrawalByReviewID(ctx, reviewID)
+	})
+}
+
+func (r *ShardedReviewRepo) SaveWithdrawal(ctx context.Context, withdrawal *model.ReviewWithdrawalInfo) error {
+	s, _, err := r.locate(ctx, withdrawal.ReviewID)
+	if err != nil {
+		return err
+	}
+	return s.SaveWithdrawal(ctx, withdrawal)
+}
+
+func (r *ShardedReviewRepo) AuditWithdrawal(ctx context.Context, withdrawal *model.ReviewWithdrawalInfo, reviewStatus int32) error {
+	s, _, err := r.locate(ctx, withdrawal.ReviewID)
+	if err != nil {
+		return err
+	}
+	return s.AuditWithdrawal(ctx, withdrawal, reviewStatus)
+}
+
+// GetProductStats 同一商品可能在多个店铺下，各分片的统计按评价数加权合并
+func (r *ShardedReviewRepo) GetProductStats(ctx context.Context, spuIDs []int64) ([]*biz.ProductStatSummary, error) {
+	list, err := fanOutList(ctx, r, func(ctx context.Context, s *reviewRepo) ([]*biz.ProductStatSummary, error) {
+		return s.GetProductStats(ctx, spuIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	merged := make(map[int64]*biz.ProductStatSummary, len(list))
+	var stats []*biz.ProductStatSummary
+	for _, stat := range list {
+		m, ok := merged[stat.ProductID]
+		if !ok {
+			copied := *stat
+			merged[stat.ProductID] = &copied
+			stats = append(stats, &copied)
+			continue
+		}
+		count := m.ReviewCount + stat.ReviewCount
+		m.AvgScore = (m.AvgScore*float64(m.ReviewCount) + stat.AvgScore*float64(stat.ReviewCount)) / float64(count)
+		m.ReviewCount = count
+		m.HasVerifiedPurchase = m.HasVerifiedPurchase || stat.HasVerifiedPurchase
+	}
+	return stats, nil
+}
+
+// ListRecentReviews 每个分片取limit条，合并后按创建时间取最近的limit条
+func (r *ShardedReviewRepo) ListRecentReviews(ctx context.Context, limit int) ([]*model.ReviewInfo, error) {
+	list, err := fanOutList(ctx, r, func(ctx context.Context, s *reviewRepo) ([]*model.ReviewInfo, error) {
+		return s.ListRecentReviews(ctx, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].CreateAt.After(list[j].CreateAt) })
+	return page(list, 0, limit), nil
+}
+
+func (r *ShardedReviewRepo) ListAnomalousReviews(ctx context.Context, storeID int64, threshold float64, limit int) ([]*model.ReviewInfo, error) {
+	return r.shardFor(storeID).ListAnomalousReviews(ctx, storeID, threshold, limit)
+}
+
+func (r *ShardedReviewRepo) GetPeriodStats(ctx context.Context, storeID int64, from, to time.Time) (*biz.PeriodStats, error) {
+	return r.shardFor(storeID).GetPeriodStats(ctx, storeID, from, to)
+}
+
+func (r *ShardedReviewRepo) ListAllReviewByUserID(ctx context.Context, userID int64) ([]*model.ReviewInfo, error) {
+	list, err := fanOutList(ctx, r, func(ctx context.Context, s *reviewRepo) ([]*model.ReviewInfo, error) {
+		return s.ListAllReviewByUserID(ctx, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ReviewID < list[j].ReviewID })
+	return list, nil
+}
+
+func (r *ShardedReviewRepo) ListWithdrawalByUserID(ctx context.Context, userID int64) ([]*model.ReviewWithdrawalInfo, error) {
+	list, err := fanOutList(ctx, r, func(ctx context.Context, s *reviewRepo) ([]*model.ReviewWithdrawalInfo, error) {
+		return s.ListWithdrawalByUserID(ctx, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].WithdrawalID < list[j].WithdrawalID })
+	return list, nil
+}
+
+// GetReviewsByStore 查询店铺下未撤回的评价，只访问一个分片
+func (r *ShardedReviewRepo) GetReviewsByStore(ctx context.Context, storeID int64, offset, limit int) ([]*model.ReviewInfo, error) {
+	q := r.shardFor(storeID).data.query
+	return q.ReviewInfo.
+		WithContext(ctx).
+		Where(q.ReviewInfo.StoreID.Eq(storeID), q.ReviewInfo.Status.Neq(biz.ReviewStatusWithdrawn)).
+		Order(q.ReviewInfo.ReviewID.Desc()).
+		Limit(limit).
+		Offset(offset).
+		Find()
+}
+
+// GetReviewsByUser 查询用户未撤回的评价
+// 同一个用户的评价分散在不同店铺的分片上，需要并发查询所有分片后合并
+func (r *ShardedReviewRepo) GetReviewsByUser(ctx context.Context, userID int64) ([]*model.ReviewInfo, error) {
+	list, err := fanOutList(ctx, r, func(ctx context.Context, s *reviewRepo) ([]*model.ReviewInfo, error) {
+		q := s.data.query
+		return q.ReviewInfo.
+			WithContext(ctx).
+			Where(q.ReviewInfo.UserID.Eq(userID), q.ReviewInfo.Status.Neq(biz.ReviewStatusWithdrawn)).
+			Find()
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortByReviewIDDesc(list)
+	return list, nil
+}
+
+// page 取合并结果中[offset, offset+limit)的部分
+func page[T any](list []T, offset, limit int) []T {
+	if offset >= len(list) {
+		return nil
+	}
+	end := offset + limit
+	if end > len(list) {
+		end = len(list)
+	}
+	return list[offset:end]
+}
@@ -0,0 +1,170 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// newTestShardedRepo 用n个sqlite内存库作为分片，返回的dbs用于直接检查每个分片的数据
+func newTestShardedRepo(t *testing.T, n int) (*ShardedReviewRepo, []*gorm.DB) {
+	t.Helper()
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	open := func(source string) *gorm.DB {
+		db, err := openDB("sqlite", source)
+		if err != nil {
+			t.Fatalf("open %s fail, err:%v", source, err)
+		}
+		if err := db.AutoMigrate(&model.ReviewInfo{}, &model.ReviewWithdrawalInfo{}); err != nil {
+			t.Fatalf("migrate %s fail, err:%v", source, err)
+		}
+		t.Cleanup(func() {
+			if sqlDB, err := db.DB(); err == nil {
+				sqlDB.Close()
+			}
+		})
+		return db
+	}
+	// 共享缓存的内存库在最后一个连接关闭前一直存在，测试持有的连接保证分片的数据不丢
+	primary := open(fmt.Sprintf("file:%s_primary?mode=memory&cache=shared", name))
+	cfg := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite"}}
+	dbs := make([]*gorm.DB, 0, n)
+	for i := 0; i < n; i++ {
+		source := fmt.Sprintf("file:%s_shard%d?mode=memory&cache=shared", name, i)
+		cfg.Database.ShardSources = append(cfg.Database.ShardSources, source)
+		dbs = append(dbs, open(source))
+	}
+	repo, cleanup, err := NewShardedReviewRepo(cfg, &Data{query: query.Use(primary)}, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("NewShardedReviewRepo fail, err:%v", err)
+	}
+	t.Cleanup(cleanup)
+	return repo, dbs
+}
+
+func TestShardedReviewRepoRoutesByStoreID(t *testing.T) {
+	const shards = 3
+	repo, dbs := newTestShardedRepo(t, shards)
+	ctx := context.Background()
+
+	// 店铺1..6各两条评价，都来自同一个用户
+	for storeID := int64(1); storeID <= 6; storeID++ {
+		for i := int64(0); i < 2; i++ {
+			reviewID := storeID*10 + i
+			review := &model.ReviewInfo{ReviewID: reviewID, OrderID: reviewID, UserID: 7, StoreID: storeID, Status: biz.ReviewStatusApproved}
+			if _, err := repo.SaveReview(ctx, review); err != nil {
+				t.Fatalf("SaveReview fail, err:%v", err)
+			}
+		}
+	}
+
+	for idx, db := range dbs {
+		var stored []*model.ReviewInfo
+		if err := db.Find(&stored).Error; err != nil {
+			t.Fatalf("read shard %d fail, err:%v", idx, err)
+		}
+		if len(stored) != 4 {
+			t.Fatalf("shard %d has %d reviews, want 4", idx, len(stored))
+		}
+		for _, review := range stored {
+			if got := int(review.StoreID % shards); got != idx {
+				t.Errorf("review %d of store %d stored on shard %d, want shard %d", review.ReviewID, review.StoreID, idx, got)
+			}
+		}
+	}
+
+	list, err := repo.ListReviewByStoreID(ctx, 5, 0, 0, 10)
+	if err != nil {
+		t.Fatalf("ListReviewByStoreID fail, err:%v", err)
+	}
+	if len(list) != 2 || list[0].ReviewID != 51 || list[1].ReviewID != 50 {
+		t.Fatalf("ListReviewByStoreID(5) = %v, want reviews [51 50]", reviewIDs(list))
+	}
+
+	// 只有reviewID的写请求先定位分片
+	err = repo.AuditReview(ctx, &biz.AuditParam{ReviewID: 40, Status: biz.ReviewStatusRejected, OpUser: "op"})
+	if err != nil {
+		t.Fatalf("AuditReview fail, err:%v", err)
+	}
+	var audited model.ReviewInfo
+	if err := dbs[4%shards].Where("review_id = ?", 40).First(&audited).Error; err != nil {
+		t.Fatalf("read audited review fail, err:%v", err)
+	}
+	if audited.Status != biz.ReviewStatusRejected {
+		t.Fatalf("audited review status = %d, want %d", audited.Status, biz.ReviewStatusRejected)
+	}
+	if _, err := repo.GetReview(ctx, 999); err != gorm.ErrRecordNotFound {
+		t.Fatalf("GetReview(999) err = %v, want ErrRecordNotFound", err)
+	}
+}
+
+func TestShardedReviewRepoFansOutByUser(t *testing.T) {
+	repo, _ := newTestShardedRepo(t, 3)
+	ctx := context.Background()
+
+	// 用户7在5个店铺的评价分散在3个分片上，店铺3的评价已撤回
+	var want []int64
+	for storeID := int64(1); storeID <= 5; storeID++ {
+		status := int32(biz.ReviewStatusApproved)
+		if storeID == 3 {
+			status = biz.ReviewStatusWithdrawn
+		}
+		reviewID := 100 + storeID
+		review := &model.ReviewInfo{ReviewID: reviewID, OrderID: reviewID, UserID: 7, StoreID: storeID, Status: status}
+		if _, err := repo.SaveReview(ctx, review); err != nil {
+			t.Fatalf("SaveReview fail, err:%v", err)
+		}
+		if status != biz.ReviewStatusWithdrawn {
+			want = append([]int64{reviewID}, want...)
+		}
+	}
+	// 其他用户的评价不返回
+	if _, err := repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: 200, OrderID: 200, UserID: 8, StoreID: 1}); err != nil {
+		t.Fatalf("SaveReview fail, err:%v", err)
+	}
+
+	got, err := repo.GetReviewsByUser(ctx, 7)
+	if err != nil {
+		t.Fatalf("GetReviewsByUser fail, err:%v", err)
+	}
+	if fmt.Sprint(reviewIDs(got)) != fmt.Sprint(want) {
+		t.Fatalf("GetReviewsByUser = %v, want %v", reviewIDs(got), want)
+	}
+
+	// 合并后再分页
+	tests := []struct {
+		offset, limit int
+		want          []int64
+	}{
+		{0, 2, []int64{105, 104}},
+		{1, 2, []int64{104, 102}},
+		{2, 10, []int64{102, 101}},
+		{4, 10, nil},
+	}
+	for _, tt := range tests {
+		got, err := repo.ListReviewByUserID(ctx, 7, tt.offset, tt.limit)
+		if err != nil {
+			t.Fatalf("ListReviewByUserID fail, err:%v", err)
+		}
+		if fmt.Sprint(reviewIDs(got)) != fmt.Sprint(tt.want) {
+			t.Errorf("ListReviewByUserID(offset=%d, limit=%d) = %v, want %v", tt.offset, tt.limit, reviewIDs(got), tt.want)
+		}
+	}
+}
+
+func reviewIDs(list []*model.ReviewInfo) []int64 {
+	var ids []int64
+	for _, review := range list {
+		ids = append(ids, review.ReviewID)
+	}
+	return ids
+}
@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"review-service/pkg/testutil"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestGetProductStats(t *testing.T) {
+	db := testutil.NewIsolatedDB(t)
+	repo := &reviewRepo{data: &Data{query: query.Use(db)}, log: log.NewHelper(log.DefaultLogger)}
+
+	// 50个商品，每个商品两条审核通过的评价，偶数商品有一条验证购买的评价；
+	// 另外每个商品一条待审核的评价，不计入统计
+	const products = 50
+	var reviews []*model.ReviewInfo
+	var reviewID int64
+	add := func(spuID int64, score, status, verified int32) {
+		reviewID++
+		reviews = append(reviews, &model.ReviewInfo{
+			ReviewID:           reviewID,
+			OrderID:            reviewID,
+			UserID:             reviewID,
+			StoreID:            1,
+			SpuID:              spuID,
+			Score:              score,
+			Status:             status,
+			Content:            "seeded review",
+			IsVerifiedPurchase: verified,
+		})
+	}
+	spuIDs := make([]int64, 0, products+1)
+	for spuID := int64(1); spuID <= products; spuID++ {
+		var verified int32
+		if spuID%2 == 0 {
+			verified = 1
+		}
+		add(spuID, 4, biz.ReviewStatusApproved, verified)
+		add(spuID, 2, biz.ReviewStatusApproved, 0)
+		add(spuID, 1, reviewStatusPending, 1)
+		spuIDs = append(spuIDs, spuID)
+	}
+	if err := db.CreateInBatches(reviews, 100).Error; err != nil {
+		t.Fatalf("seed reviews fail, err:%v", err)
+	}
+	// 没有评价的商品不返回
+	spuIDs = append(spuIDs, products+1)
+
+	stats, err := repo.GetProductStats(context.Background(), spuIDs)
+	if err != nil {
+		t.Fatalf("GetProductStats fail, err:%v", err)
+	}
+	if len(stats) != products {
+		t.Fatalf("got %d stats, want %d", len(stats), products)
+	}
+	for _, s := range stats {
+		if s.ReviewCount != 2 || s.AvgScore != 3 {
+			t.Fatalf("product %d: count=%d avg=%v, want count=2 avg=3", s.ProductID, s.ReviewCount, s.AvgScore)
+		}
+		if want := s.ProductID%2 == 0; s.HasVerifiedPurchase != want {
+			t.Fatalf("product %d: HasVerifiedPurchase=%v, want %v", s.ProductID, s.HasVerifiedPurchase, want)
+		}
+	}
+}
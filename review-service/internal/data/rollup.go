@@ -0,0 +1,52 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+type rollupRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewRollupRepo .
+func NewRollupRepo(data *Data, logger log.Logger) biz.RollupRepo {
+	return &rollupRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *rollupRepo) CountReviewByStore(ctx context.Context, from, to time.Time) ([]*biz.StoreReviewCount, error) {
+	ri := r.data.query.ReviewInfo
+	var counts []*biz.StoreReviewCount
+	err := ri.WithContext(ctx).
+		Select(ri.StoreID, ri.ID.Count().As("review_count")).
+		Where(ri.CreateAt.Gte(from), ri.CreateAt.Lt(to)).
+		Group(ri.StoreID).
+		Scan(&counts)
+	return counts, err
+}
+
+func (r *rollupRepo) SaveCreationRollups(ctx context.Context, rollups []*model.ReviewCreationRollup) error {
+	// 依赖 uk_store_hour 唯一索引，重复汇总同一小时时覆盖计数
+	return r.data.query.ReviewCreationRollup.
+		WithContext(ctx).
+		Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns([]string{"review_count"})}).
+		Create(rollups...)
+}
+
+func (r *rollupRepo) ListCreationRollup(ctx context.Context, storeID int64, from, to time.Time) ([]*model.ReviewCreationRollup, error) {
+	rr := r.data.query.ReviewCreationRollup
+	return rr.WithContext(ctx).
+		Where(rr.StoreID.Eq(storeID), rr.Hour.Gte(from), rr.Hour.Lt(to)).
+		Order(rr.Hour).
+		Find()
+}
@@ -0,0 +1,85 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestRollupHour(t *testing.T) {
+	db, err := openDB("sqlite", "file:TestRollupHour?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatalf("open db fail, err:%v", err)
+	}
+	if err := db.AutoMigrate(&model.ReviewInfo{}, &model.ReviewCreationRollup{}); err != nil {
+		t.Fatalf("migrate fail, err:%v", err)
+	}
+	// 与review.sql的uk_store_hour一致，重复汇总时按它覆盖
+	if err := db.Exec("CREATE UNIQUE INDEX uk_store_hour ON review_creation_rollup(store_id, hour)").Error; err != nil {
+		t.Fatalf("create index fail, err:%v", err)
+	}
+	uc := biz.NewRollupUsecase(NewRollupRepo(&Data{query: query.Use(db)}, log.DefaultLogger), log.DefaultLogger)
+	ctx := context.Background()
+
+	hour := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	var reviewID int64
+	add := func(storeID int64, createAt time.Time) {
+		reviewID++
+		review := &model.ReviewInfo{ReviewID: reviewID, OrderID: reviewID, StoreID: storeID, CreateAt: createAt, UpdateAt: createAt}
+		if err := db.Create(review).Error; err != nil {
+			t.Fatalf("seed review fail, err:%v", err)
+		}
+	}
+	// 10点内店铺1三条、店铺2一条；9:59和11:00的评价不属于这个小时
+	add(1, hour)
+	add(1, hour.Add(20*time.Minute))
+	add(1, hour.Add(59*time.Minute))
+	add(2, hour.Add(30*time.Minute))
+	add(1, hour.Add(-time.Minute))
+	add(2, hour.Add(time.Hour))
+
+	// 传入小时内的任意时刻都汇总整点小时
+	if err := uc.RollupHour(ctx, hour.Add(15*time.Minute)); err != nil {
+		t.Fatalf("RollupHour fail, err:%v", err)
+	}
+	tests := []struct {
+		storeID int64
+		want    int64
+	}{
+		{1, 3},
+		{2, 1},
+		{3, 0},
+	}
+	check := func() {
+		for _, tt := range tests {
+			points, err := uc.GetCreationTrend(ctx, tt.storeID, hour.Add(-time.Hour), hour.Add(2*time.Hour))
+			if err != nil {
+				t.Fatalf("GetCreationTrend fail, err:%v", err)
+			}
+			if tt.want == 0 {
+				if len(points) != 0 {
+					t.Errorf("store %d trend = %d points, want none", tt.storeID, len(points))
+				}
+				continue
+			}
+			if len(points) != 1 || !points[0].Hour.Equal(hour) || points[0].Count != tt.want {
+				t.Errorf("store %d trend = %+v, want one point {Hour:%v Count:%d}", tt.storeID, points, hour, tt.want)
+			}
+		}
+	}
+	check()
+
+	// 重新汇总同一小时覆盖原来的计数，不产生重复的点
+	add(2, hour.Add(45*time.Minute))
+	tests[1].want = 2
+	if err := uc.RollupHour(ctx, hour); err != nil {
+		t.Fatalf("RollupHour again fail, err:%v", err)
+	}
+	check()
+}
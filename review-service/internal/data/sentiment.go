@@ -0,0 +1,49 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type sentimentRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSentimentRepo .
+func NewSentimentRepo(data *Data, logger log.Logger) biz.SentimentRepo {
+	return &sentimentRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListDailySentiment 按评价创建日期分组，日期按数据库的时区计算
+func (r *sentimentRepo) ListDailySentiment(ctx context.Context, from, to time.Time) (map[int64][]float64, error) {
+	var rows []struct {
+		SpuID int64
+		Score float64
+	}
+	err := r.data.query.ReviewInfo.
+		WithContext(ctx).
+		UnderlyingDB().
+		Model(&model.ReviewInfo{}).
+		Select("spu_id, DATE(create_at) AS day, AVG(score) AS score").
+		Where("create_at >= ? AND create_at < ? AND status = ?", from, to, biz.ReviewStatusApproved).
+		Group("spu_id, day").
+		Order("spu_id, day").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	sentiments := make(map[int64][]float64)
+	for _, row := range rows {
+		sentiments[row.SpuID] = append(sentiments[row.SpuID], row.Score)
+	}
+	return sentiments, nil
+}
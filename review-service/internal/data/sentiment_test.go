@@ -0,0 +1,52 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestListDailySentiment(t *testing.T) {
+	db, err := openDB("sqlite", "file:TestListDailySentiment?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatalf("open db fail, err:%v", err)
+	}
+	if err := db.AutoMigrate(&model.ReviewInfo{}); err != nil {
+		t.Fatalf("migrate fail, err:%v", err)
+	}
+	repo := NewSentimentRepo(&Data{query: query.Use(db)}, log.DefaultLogger)
+
+	day := func(d, hour int) time.Time { return time.Date(2026, 3, d, hour, 0, 0, 0, time.UTC) }
+	var reviewID int64
+	add := func(spuID int64, score, status int32, createAt time.Time) {
+		reviewID++
+		review := &model.ReviewInfo{ReviewID: reviewID, OrderID: reviewID, SpuID: spuID, Score: score, Status: status, CreateAt: createAt, UpdateAt: createAt}
+		if err := db.Create(review).Error; err != nil {
+			t.Fatalf("seed review fail, err:%v", err)
+		}
+	}
+	// 商品1：3月1日两条（4和2分），3月2日一条5分；商品2：3月2日一条3分
+	add(1, 4, biz.ReviewStatusApproved, day(1, 9))
+	add(1, 2, biz.ReviewStatusApproved, day(1, 20))
+	add(1, 5, biz.ReviewStatusApproved, day(2, 8))
+	add(2, 3, biz.ReviewStatusApproved, day(2, 10))
+	// 未审核通过的评价和区间外的评价不计入
+	add(1, 1, biz.ReviewStatusRejected, day(1, 10))
+	add(1, 1, biz.ReviewStatusApproved, day(3, 0))
+
+	got, err := repo.ListDailySentiment(context.Background(), day(1, 0), day(3, 0))
+	if err != nil {
+		t.Fatalf("ListDailySentiment fail, err:%v", err)
+	}
+	want := map[int64][]float64{1: {3, 5}, 2: {3}}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("ListDailySentiment = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,155 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"review-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	reviewSessionKeyPrefix = "review:session:"
+	// reviewUserSessionsKeyPrefix 用户的会话ID集合，用于导出用户数据
+	// 集合的过期时间随会话续期，会话过期或删除后集合里留下的ID在查询时清理
+	reviewUserSessionsKeyPrefix = "review:session:user:"
+	// reviewSessionUpdateRetries 并发修改同一个会话冲突时的最大尝试次数
+	reviewSessionUpdateRetries = 5
+)
+
+type reviewSessionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewReviewSessionRepo .
+func NewReviewSessionRepo(data *Data, logger log.Logger) biz.ReviewSessionRepo {
+	return &reviewSessionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func reviewUserSessionsKey(userID int64) string {
+	return reviewUserSessionsKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+// SaveReviewSession 会话序列化成JSON写入Redis，过期时间ttl
+func (r *reviewSessionRepo) SaveReviewSession(ctx context.Context, session *biz.ReviewSession, ttl time.Duration) error {
+	b, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	_, err = r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, reviewSessionKeyPrefix+session.SessionID, b, ttl)
+		indexUserSession(ctx, pipe, session, ttl)
+		return nil
+	})
+	return err
+}
+
+// indexUserSession 把会话ID加入用户的会话集合并续期
+func indexUserSession(ctx context.Context, pipe redis.Pipeliner, session *biz.ReviewSession, ttl time.Duration) {
+	key := reviewUserSessionsKey(session.UserID)
+	pipe.SAdd(ctx, key, session.SessionID)
+	pipe.Expire(ctx, key, ttl)
+}
+
+func (r *reviewSessionRepo) GetReviewSession(ctx context.Context, sessionID string) (*biz.ReviewSession, error) {
+	session, err := decodeReviewSession(r.data.rdb.Get(ctx, reviewSessionKeyPrefix+sessionID))
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("GetReviewSession fail, err:%v", err)
+		return nil, err
+	}
+	return session, nil
+}
+
+// UpdateReviewSession WATCH会话的key后读取、修改，在事务中写回
+// 期间会话被其他请求修改时事务不执行，重新读取后再试，最多reviewSessionUpdateRetries次
+func (r *reviewSessionRepo) UpdateReviewSession(ctx context.Context, sessionID string, ttl time.Duration, fn func(session *biz.ReviewSession) error) error {
+	key := reviewSessionKeyPrefix + sessionID
+	update := func(tx *redis.Tx) error {
+		session, err := decodeReviewSession(tx.Get(ctx, key))
+		if err != nil {
+			return err
+		}
+		if err := fn(session); err != nil {
+			return err
+		}
+		b, err := json.Marshal(session)
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, b, ttl)
+			indexUserSession(ctx, pipe, session, ttl)
+			return nil
+		})
+		return err
+	}
+	for i := 0; i < reviewSessionUpdateRetries; i++ {
+		err := r.data.rdb.Watch(ctx, update, key)
+		if !errors.Is(err, redis.TxFailedErr) {
+			return err
+		}
+	}
+	r.log.WithContext(ctx).Errorf("UpdateReviewSession conflict, sessionID:%v", sessionID)
+	return redis.TxFailedErr
+}
+
+// decodeReviewSession 会话不存在时返回(nil, nil)
+func decodeReviewSession(cmd *redis.StringCmd) (*biz.ReviewSession, error) {
+	b, err := cmd.Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	session := new(biz.ReviewSession)
+	if err := json.Unmarshal(b, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (r *reviewSessionRepo) DeleteReviewSession(ctx context.Context, sessionID string) error {
+	return r.data.rdb.Del(ctx, reviewSessionKeyPrefix+sessionID).Err()
+}
+
+// ListReviewSessionByUserID 读取用户会话集合中的所有会话，已经过期或删除的会话从集合中移除
+func (r *reviewSessionRepo) ListReviewSessionByUserID(ctx context.Context, userID int64) ([]*biz.ReviewSession, error) {
+	key := reviewUserSessionsKey(userID)
+	sessionIDs, err := r.data.rdb.SMembers(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(sessionIDs)
+	var (
+		list  []*biz.ReviewSession
+		stale []interface{}
+	)
+	for _, sessionID := range sessionIDs {
+		session, err := decodeReviewSession(r.data.rdb.Get(ctx, reviewSessionKeyPrefix+sessionID))
+		if err != nil {
+			return nil, err
+		}
+		if session == nil {
+			stale = append(stale, sessionID)
+			continue
+		}
+		list = append(list, session)
+	}
+	if len(stale) > 0 {
+		if err := r.data.rdb.SRem(ctx, key, stale...).Err(); err != nil {
+			r.log.WithContext(ctx).Warnf("ListReviewSessionByUserID remove stale sessions fail, userID:%v err:%v", userID, err)
+		}
+	}
+	return list, nil
+}
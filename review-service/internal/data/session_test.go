@@ -0,0 +1,116 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"review-service/internal/biz"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// TestUpdateReviewSessionConcurrent 并发修改同一个会话时每个修改都保留
+func TestUpdateReviewSessionConcurrent(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+	repo := NewReviewSessionRepo(&Data{rdb: rdb}, log.DefaultLogger)
+	ctx := context.Background()
+
+	session := &biz.ReviewSession{SessionID: "s1", UserID: 1, PartialData: map[string]interface{}{}}
+	if err := repo.SaveReviewSession(ctx, session, time.Minute); err != nil {
+		t.Fatalf("SaveReviewSession fail, err:%v", err)
+	}
+	const writers = 4
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := repo.UpdateReviewSession(ctx, "s1", time.Minute, func(session *biz.ReviewSession) error {
+				session.PartialData[fmt.Sprintf("field%d", i)] = i
+				return nil
+			})
+			if err != nil {
+				t.Errorf("writer %d fail, err:%v", i, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	got, err := repo.GetReviewSession(ctx, "s1")
+	if err != nil {
+		t.Fatalf("GetReviewSession fail, err:%v", err)
+	}
+	if len(got.PartialData) != writers {
+		t.Fatalf("partial data = %v, want %d fields", got.PartialData, writers)
+	}
+}
+
+func TestUpdateReviewSessionExpired(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+	repo := NewReviewSessionRepo(&Data{rdb: rdb}, log.DefaultLogger)
+	ctx := context.Background()
+
+	if err := repo.SaveReviewSession(ctx, &biz.ReviewSession{SessionID: "s1"}, time.Minute); err != nil {
+		t.Fatalf("SaveReviewSession fail, err:%v", err)
+	}
+	mr.FastForward(2 * time.Minute)
+	var got *biz.ReviewSession
+	errNotFound := fmt.Errorf("not found")
+	err := repo.UpdateReviewSession(ctx, "s1", time.Minute, func(session *biz.ReviewSession) error {
+		got = session
+		return errNotFound
+	})
+	if err != errNotFound || got != nil {
+		t.Fatalf("err = %v session = %v, want fn called with nil and its error returned", err, got)
+	}
+	if mr.Exists(reviewSessionKeyPrefix + "s1") {
+		t.Fatal("expired session written back")
+	}
+}
+
+// TestListReviewSessionByUserID 只返回用户未过期的会话，过期的会话从集合中清理
+func TestListReviewSessionByUserID(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+	repo := NewReviewSessionRepo(&Data{rdb: rdb}, log.DefaultLogger)
+	ctx := context.Background()
+
+	save := func(sessionID string, userID int64, ttl time.Duration) {
+		session := &biz.ReviewSession{SessionID: sessionID, UserID: userID, PartialData: map[string]interface{}{}}
+		if err := repo.SaveReviewSession(ctx, session, ttl); err != nil {
+			t.Fatalf("SaveReviewSession fail, err:%v", err)
+		}
+	}
+	save("s1", 1, time.Minute)
+	save("s2", 1, time.Hour)
+	save("s3", 2, time.Hour)
+	// s1过期，s2修改后续期到两小时
+	mr.FastForward(2 * time.Minute)
+	if err := repo.UpdateReviewSession(ctx, "s2", 2*time.Hour, func(session *biz.ReviewSession) error { return nil }); err != nil {
+		t.Fatalf("UpdateReviewSession fail, err:%v", err)
+	}
+
+	got, err := repo.ListReviewSessionByUserID(ctx, 1)
+	if err != nil {
+		t.Fatalf("ListReviewSessionByUserID fail, err:%v", err)
+	}
+	if len(got) != 1 || got[0].SessionID != "s2" {
+		t.Fatalf("ListReviewSessionByUserID(1) = %+v, want session s2", got)
+	}
+	if members, _ := mr.Members(reviewUserSessionsKey(1)); len(members) != 1 || members[0] != "s2" {
+		t.Fatalf("user sessions = %v, want [s2]", members)
+	}
+	if ttl := mr.TTL(reviewUserSessionsKey(1)); ttl != 2*time.Hour {
+		t.Fatalf("user sessions ttl = %v, want %v", ttl, 2*time.Hour)
+	}
+}
@@ -0,0 +1,89 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const trendingTagsKeyPrefix = "review:trending:"
+
+type tagTrendRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTagTrendRepo .
+func NewTagTrendRepo(data *Data, logger log.Logger) biz.TagTrendRepo {
+	return &tagTrendRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CountTagsByStore 按评价的创建时间统计，已撤回的评价不计入
+func (r *tagTrendRepo) CountTagsByStore(ctx context.Context, storeID int64, from, to time.Time) (map[int64]int64, error) {
+	var rows []struct {
+		TagID int64
+		Count int64
+	}
+	err := r.data.query.ReviewTagMap.
+		WithContext(ctx).
+		UnderlyingDB().
+		Select("review_tag_map.tag_id AS tag_id, COUNT(*) AS count").
+		Joins("JOIN review_info ON review_info.review_id = review_tag_map.review_id").
+		Where("review_info.store_id = ? AND review_info.create_at >= ? AND review_info.create_at < ? AND review_info.status <> ?",
+			storeID, from, to, biz.ReviewStatusWithdrawn).
+		Group("review_tag_map.tag_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.TagID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *tagTrendRepo) ListReviewTags(ctx context.Context, tagIDs []int64) ([]*model.ReviewTagInfo, error) {
+	rt := r.data.query.ReviewTagInfo
+	return rt.WithContext(ctx).
+		Where(rt.TagID.In(tagIDs...)).
+		Find()
+}
+
+func trendingTagsKey(storeID int64, topN int) string {
+	return fmt.Sprintf("%s%d:%d", trendingTagsKeyPrefix, storeID, topN)
+}
+
+func (r *tagTrendRepo) GetCachedTrendingTags(ctx context.Context, storeID int64, topN int) ([]*biz.TrendingTag, error) {
+	b, err := r.data.rdb.Get(ctx, trendingTagsKey(storeID, topN)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	tags := []*biz.TrendingTag{}
+	if err := json.Unmarshal(b, &tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+func (r *tagTrendRepo) SaveTrendingTags(ctx context.Context, storeID int64, topN int, tags []*biz.TrendingTag, ttl time.Duration) error {
+	b, err := json.Marshal(tags)
+	if err != nil {
+		return err
+	}
+	return r.data.rdb.Set(ctx, trendingTagsKey(storeID, topN), b, ttl).Err()
+}
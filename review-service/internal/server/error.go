@@ -0,0 +1,51 @@
+package server
+
+import (
+	"encoding/json"
+	nethttp "net/http"
+
+	pkgerrors "review-service/pkg/errors"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// problemTypePrefix 错误类型URI的前缀，后面拼接错误原因，同一个原因的type始终相同
+const problemTypePrefix = "urn:review-service:problem:"
+
+// problemDetails RFC 7807 错误响应
+type problemDetails struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail"`
+	Resource string `json:"resource,omitempty"`
+	ID       string `json:"id,omitempty"`
+}
+
+// errorEncoder 所有错误都按RFC 7807返回，type由错误原因决定，资源不存在时带上资源类型和ID
+// 没有错误原因的错误（未知错误）type为about:blank
+func errorEncoder(w nethttp.ResponseWriter, r *nethttp.Request, err error) {
+	se := errors.FromError(err)
+	problem := &problemDetails{
+		Type:   "about:blank",
+		Title:  nethttp.StatusText(int(se.Code)),
+		Status: int(se.Code),
+		Detail: se.Message,
+	}
+	if se.Reason != "" {
+		problem.Type = problemTypePrefix + se.Reason
+		problem.Title = se.Reason
+	}
+	if se.Reason == pkgerrors.ReasonResourceNotFound {
+		problem.Resource = se.Metadata[pkgerrors.MetadataResource]
+		problem.ID = se.Metadata[pkgerrors.MetadataID]
+	}
+	body, err := json.Marshal(problem)
+	if err != nil {
+		w.WriteHeader(nethttp.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(int(se.Code))
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/service"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// notFoundReviewRepo 查询任何评价都不存在
+type notFoundReviewRepo struct {
+	biz.ReviewRepo
+}
+
+func (notFoundReviewRepo) GetReview(context.Context, int64) (*model.ReviewInfo, error) {
+	return nil, gorm.ErrRecordNotFound
+}
+
+func TestErrorEncoderProblemDetails(t *testing.T) {
+	t.Setenv(adminKeyEnv, testAdminKey)
+	uc := biz.NewReviewUsecase(notFoundReviewRepo{}, nil, nil, &conf.Business{}, log.DefaultLogger)
+	srv := NewHTTPServer(&conf.Server{Http: &conf.Server_HTTP{}}, service.NewReviewService(uc, nil, nil, nil, nil, nil), log.DefaultLogger)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		want   problemDetails
+	}{
+		{
+			name:   "review not found",
+			method: http.MethodGet,
+			path:   "/v1/review/42",
+			want: problemDetails{
+				Type:     "urn:review-service:problem:RESOURCE_NOT_FOUND",
+				Title:    "RESOURCE_NOT_FOUND",
+				Status:   http.StatusNotFound,
+				Detail:   "review:42不存在",
+				Resource: "review",
+				ID:       "42",
+			},
+		},
+		{
+			name:   "validation error",
+			method: http.MethodPost,
+			path:   "/v1/review",
+			body:   `{"userID":1}`,
+			want: problemDetails{
+				Type:   "urn:review-service:problem:VALIDATOR",
+				Title:  "VALIDATOR",
+				Status: http.StatusBadRequest,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+			if rec.Code != tt.want.Status {
+				t.Fatalf("status = %d, want %d, body:%s", rec.Code, tt.want.Status, rec.Body.String())
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+				t.Fatalf("Content-Type = %q, want application/problem+json", ct)
+			}
+			var got problemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("unmarshal problem fail, err:%v", err)
+			}
+			// 参数校验的detail是校验器生成的描述，只检查不为空
+			if tt.want.Detail == "" {
+				if got.Detail == "" {
+					t.Fatalf("problem detail is empty, body:%s", rec.Body.String())
+				}
+				got.Detail = ""
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("problem = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
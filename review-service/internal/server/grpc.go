@@ -4,6 +4,10 @@ import (
 	v1 "review-service/api/review/v1"
 	"review-service/internal/conf"
 	"review-service/internal/service"
+	"review-service/pkg/budget"
+	"review-service/pkg/env"
+	"review-service/pkg/metrics"
+	"review-service/pkg/middleware"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -12,11 +16,18 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, reviewer *service.ReviewService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, d *conf.Data, lag *middleware.LagMonitor, reviewer *service.ReviewService, logger log.Logger) *grpc.Server {
+	adminKey := env.OptionalString(adminKeyEnv, "")
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			// 指标放在参数校验之前，校验失败的请求也要计入
+			metrics.Server(metrics.Default()),
+			metrics.Latency(),
+			adminAuth(adminKey),
+			userAuth(adminKey),
 			validate.Validator(),
+			budget.Server(c.GetLatencyBudget().AsDuration()),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -28,6 +39,14 @@ func NewGRPCServer(c *conf.Server, reviewer *service.ReviewService, logger log.L
 	if c.Grpc.Timeout != nil {
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
+	// 原生gRPC拦截器，按优先级排序
+	chain := middleware.NewChainBuilder()
+	if lag != nil {
+		chain.Add("lag_shed", middleware.LagShedInterceptor(lag, d.Database.GetMaxReplicaLag().AsDuration().Milliseconds()), 10)
+	}
+	if interceptors := chain.Interceptors(); len(interceptors) > 0 {
+		opts = append(opts, grpc.UnaryInterceptor(interceptors...))
+	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterReviewServer(srv, reviewer)
 	return srv
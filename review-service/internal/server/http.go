@@ -1,23 +1,39 @@
 package server
 
 import (
+	nethttp "net/http"
+
+	"review-service/api/openapi"
 	v1 "review-service/api/review/v1"
 	"review-service/internal/conf"
 	"review-service/internal/service"
+	"review-service/pkg/budget"
+	"review-service/pkg/env"
+	"review-service/pkg/metrics"
+	"review-service/pkg/middleware"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 // NewHTTPServer new an HTTP server.
 func NewHTTPServer(c *conf.Server, reviewer *service.ReviewService, logger log.Logger) *http.Server {
+	adminKey := env.OptionalString(adminKeyEnv, "")
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			// 指标放在参数校验之前，校验失败的请求也要计入
+			metrics.Server(metrics.Default()),
+			metrics.Latency(),
+			adminAuth(adminKey),
+			userAuth(adminKey),
 			validate.Validator(),
+			budget.Server(c.GetLatencyBudget().AsDuration()),
 		),
+		http.ErrorEncoder(errorEncoder),
 	}
 	if c.Http.Network != "" {
 		opts = append(opts, http.Network(c.Http.Network))
@@ -30,5 +46,16 @@ func NewHTTPServer(c *conf.Server, reviewer *service.ReviewService, logger log.L
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterReviewHTTPServer(srv, reviewer)
+	// 管理后台使用的JSON格式指标，原生handler不经过上面的中间件，单独校验管理密钥
+	srv.Handle("/admin/metrics", middleware.AdminKeyHandler(adminKey, nethttp.HandlerFunc(metrics.Default().Handler), errorEncoder))
+	// Prometheus指标
+	srv.Handle("/metrics", promhttp.Handler())
+	// 用户个人数据下载，handler里通过ctx.Middleware执行上面的中间件
+	srv.Route("/").GET("/users/{id}/data-export", reviewer.DownloadUserDataExport)
+	// 接口文档，供前端生成客户端代码
+	srv.HandleFunc("/openapi.json", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(openapi.ReviewSwagger)
+	})
 	return srv
 }
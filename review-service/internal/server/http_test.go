@@ -0,0 +1,114 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"review-service/internal/conf"
+	"review-service/pkg/middleware"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const testAdminKey = "test-admin-key"
+
+// newTestHTTPServer 不监听端口，直接通过ServeHTTP处理请求
+func newTestHTTPServer(t *testing.T) http.Handler {
+	t.Helper()
+	t.Setenv(adminKeyEnv, testAdminKey)
+	return NewHTTPServer(&conf.Server{Http: &conf.Server_HTTP{}}, nil, log.DefaultLogger)
+}
+
+func TestAdminMetricsRequiresAdminKey(t *testing.T) {
+	srv := newTestHTTPServer(t)
+	tests := []struct {
+		name string
+		key  string
+		want int
+	}{
+		{"admin", testAdminKey, http.StatusOK},
+		{"missing key", "", http.StatusUnauthorized},
+		{"wrong key", "guess", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/admin/metrics", nil)
+			if tt.key != "" {
+				req.Header.Set(middleware.AdminKeyHeader, tt.key)
+			}
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d, body:%s", rec.Code, tt.want, rec.Body.String())
+			}
+		})
+	}
+}
+
+// TestReviewSessionRequiresOwner 评价会话接口的userID必须是网关写入的当前登录用户
+func TestReviewSessionRequiresOwner(t *testing.T) {
+	srv := newTestHTTPServer(t)
+	tests := []struct {
+		name   string
+		path   string
+		header string
+		want   int
+	}{
+		{"start not logged in", "/v1/review/session", "", http.StatusUnauthorized},
+		{"start as another user", "/v1/review/session", "2", http.StatusForbidden},
+		{"update as another user", "/v1/review/session/s1/step", "2", http.StatusForbidden},
+		{"commit as another user", "/v1/review/session/s1/commit", "2", http.StatusForbidden},
+	}
+	body := `{"userID":1,"orderID":1,"storeID":1,"spuID":1,"skuID":1,"step":1}`
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.header != "" {
+				req.Header.Set(middleware.UserIDHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d, body:%s", rec.Code, tt.want, rec.Body.String())
+			}
+		})
+	}
+}
+
+// TestCreateReviewRequiresProduct 创建评价必须带上商品，缺少spuID或skuID时参数校验失败
+func TestCreateReviewRequiresProduct(t *testing.T) {
+	srv := newTestHTTPServer(t)
+	tests := []struct {
+		name    string
+		product string
+		field   string
+	}{
+		{"missing spuID", `"skuID":1`, "SpuID"},
+		{"missing skuID", `"spuID":1`, "SkuID"},
+		{"zero spuID", `"spuID":0,"skuID":1`, "SpuID"},
+		{"negative skuID", `"spuID":1,"skuID":-1`, "SkuID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := `{"userID":1,"orderID":1,"storeID":1,"score":5,"serviceScore":5,"expressScore":5,"content":"质量很好，物流也很快",` + tt.product + `}`
+			req := httptest.NewRequest(http.MethodPost, "/v1/review", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want %d, body:%s", rec.Code, http.StatusBadRequest, rec.Body.String())
+			}
+			var problem problemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("unmarshal error body fail, err:%v", err)
+			}
+			if problem.Title != "VALIDATOR" || !strings.Contains(problem.Detail, tt.field) {
+				t.Fatalf("error = %s, want VALIDATOR error on %s", rec.Body.String(), tt.field)
+			}
+		})
+	}
+}
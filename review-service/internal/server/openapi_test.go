@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// TestOpenAPISpecValid 校验/openapi.json返回的文档
+// protoc-gen-openapiv2生成的是Swagger 2.0，先转换成OpenAPI 3再校验
+func TestOpenAPISpecValid(t *testing.T) {
+	srv := newTestHTTPServer(t)
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /openapi.json status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("GET /openapi.json Content-Type = %q, want application/json", ct)
+	}
+
+	var v2 openapi2.T
+	if err := json.Unmarshal(rec.Body.Bytes(), &v2); err != nil {
+		t.Fatalf("unmarshal swagger fail, err:%v", err)
+	}
+	v3, err := openapi2conv.ToV3(&v2)
+	if err != nil {
+		t.Fatalf("convert swagger to openapi3 fail, err:%v", err)
+	}
+	b, err := json.Marshal(v3)
+	if err != nil {
+		t.Fatalf("marshal openapi3 fail, err:%v", err)
+	}
+	doc, err := openapi3.NewLoader().LoadFromData(b)
+	if err != nil {
+		t.Fatalf("load openapi3 fail, err:%v", err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		t.Fatalf("openapi spec invalid, err:%v", err)
+	}
+	if doc.Paths.Find("/v1/review") == nil {
+		t.Fatal("openapi spec has no /v1/review path")
+	}
+}
@@ -1,9 +1,13 @@
 package server
 
 import (
+	v1 "review-service/api/review/v1"
 	"review-service/internal/conf"
+	"review-service/pkg/middleware"
 
 	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	kratosmiddleware "github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/registry"
 	"github.com/google/wire"
 	"github.com/hashicorp/consul/api"
@@ -12,6 +16,40 @@ import (
 // ProviderSet is server providers.
 var ProviderSet = wire.NewSet(NewRegistrar, NewGRPCServer, NewHTTPServer)
 
+// adminKeyEnv 管理密钥的环境变量，密钥不写进配置文件
+const adminKeyEnv = "ADMIN_KEY"
+
+// adminOperations 需要管理密钥的接口，gRPC和HTTP的operation相同
+var adminOperations = []string{
+	v1.OperationReviewAuditReview,
+	v1.OperationReviewListReviewByStoreID,
+	v1.OperationReviewApproveWithdrawal,
+	v1.OperationReviewDenyWithdrawal,
+	v1.OperationReviewGetPlatformReport,
+	v1.OperationReviewDequeueModeration,
+	v1.OperationReviewGetModerationQueueDepth,
+	v1.OperationReviewBulkTagReviews,
+	v1.OperationReviewListAnomalousReviews,
+}
+
+// userOperations 只允许用户本人或者管理员访问的接口
+var userOperations = []string{
+	v1.Review_ExportUserData_FullMethodName,
+	v1.OperationReviewStartReviewSession,
+	v1.OperationReviewUpdateReviewSession,
+	v1.OperationReviewCommitReviewSession,
+}
+
+// adminAuth 只在管理接口上校验管理密钥
+func adminAuth(key string) kratosmiddleware.Middleware {
+	return selector.Server(middleware.AdminKey(key)).Path(adminOperations...).Build()
+}
+
+// userAuth 用户个人数据接口校验当前登录用户
+func userAuth(adminKey string) kratosmiddleware.Middleware {
+	return selector.Server(middleware.SelfOrAdmin(adminKey)).Path(userOperations...).Build()
+}
+
 func NewRegistrar(conf *conf.Registry) registry.Registrar {
 	// new consul client
 	c := api.DefaultConfig()
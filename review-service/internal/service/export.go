@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	pb "review-service/api/review/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// ExportSignatureHeader 下载个人数据时返回签名的响应头
+const ExportSignatureHeader = "X-Signature"
+
+// DownloadUserDataExport 以附件形式下载用户的个人数据，挂载到 GET /users/{id}/data-export
+// 与ExportUserData使用同一个operation，经过相同的中间件（鉴权、参数校验、指标）
+func (s *ReviewService) DownloadUserDataExport(ctx http.Context) error {
+	userID, err := strconv.ParseInt(ctx.Vars().Get("id"), 10, 64)
+	if err != nil || userID <= 0 {
+		return errors.BadRequest("INVALID_USER_ID", "无效的用户id")
+	}
+	http.SetOperation(ctx, pb.Review_ExportUserData_FullMethodName)
+	h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.ExportUserData(ctx, req.(*pb.ExportUserDataRequest))
+	})
+	out, err := h(ctx, &pb.ExportUserDataRequest{UserID: userID})
+	if err != nil {
+		return err
+	}
+	reply := out.(*pb.ExportUserDataReply)
+	header := ctx.Response().Header()
+	header.Set("Content-Type", "application/json")
+	header.Set("Content-Disposition", `attachment; filename="data-export.json"`)
+	header.Set(ExportSignatureHeader, reply.GetSignature())
+	_, err = ctx.Response().Write(reply.GetData())
+	return err
+}
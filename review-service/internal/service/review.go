@@ -3,20 +3,48 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	pb "review-service/api/review/v1"
 	"review-service/internal/biz"
 	"review-service/internal/data/model"
+	"review-service/pkg/convert"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
 )
 
+// timezoneHeader 客户端上报的评价者时区，相对UTC的分钟数
+const timezoneHeader = "x-user-timezone"
+
 type ReviewService struct {
 	pb.UnimplementedReviewServer
 
-	uc *biz.ReviewUsecase
+	uc     *biz.ReviewUsecase
+	queue  *biz.ReviewPriorityQueue
+	report *biz.ReportUsecase
+	// compliance 用户个人数据导出
+	compliance *biz.ComplianceUsecase
+	trend      *biz.TagTrendUsecase
+	// session 分步填写评价的会话
+	session *biz.ReviewSessionUsecase
+}
+
+func NewReviewService(uc *biz.ReviewUsecase, queue *biz.ReviewPriorityQueue, report *biz.ReportUsecase, compliance *biz.ComplianceUsecase, trend *biz.TagTrendUsecase, session *biz.ReviewSessionUsecase) *ReviewService {
+	return &ReviewService{uc: uc, queue: queue, report: report, compliance: compliance, trend: trend, session: session}
 }
 
-func NewReviewService(uc *biz.ReviewUsecase) *ReviewService {
-	return &ReviewService{uc: uc}
+// timezoneOffsetFromHeader 读取客户端上报的时区，没有上报时为0
+func timezoneOffsetFromHeader(ctx context.Context) (int32, error) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	offset, err := convert.ParseTimezoneOffset(tr.RequestHeader().Get(timezoneHeader))
+	if err != nil {
+		return 0, errors.BadRequest("INVALID_TIMEZONE", err.Error())
+	}
+	return offset, nil
 }
 
 // CreateReview 创建评价
@@ -28,17 +56,25 @@ func (s *ReviewService) CreateReview(ctx context.Context, req *pb.CreateReviewRe
 	if req.Anonymous {
 		anonymous = 1
 	}
+	timezoneOffset, err := timezoneOffsetFromHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
 	review, err := s.uc.CreateReview(ctx, &model.ReviewInfo{
-		UserID:       req.UserID,
-		OrderID:      req.OrderID,
-		Score:        req.Score,
-		ServiceScore: req.Score,
-		ExpressScore: req.ExpressScore,
-		Content:      req.Content,
-		PicInfo:      req.PicInfo,
-		VideoInfo:    req.VideoInfo,
-		Anonymous:    anonymous,
-		Status:       0,
+		UserID:         req.UserID,
+		OrderID:        req.OrderID,
+		StoreID:        req.StoreID,
+		SpuID:          req.SpuID,
+		SkuID:          req.SkuID,
+		Score:          req.Score,
+		ServiceScore:   req.Score,
+		ExpressScore:   req.ExpressScore,
+		Content:        req.Content,
+		PicInfo:        req.PicInfo,
+		VideoInfo:      req.VideoInfo,
+		Anonymous:      anonymous,
+		TimezoneOffset: timezoneOffset,
+		Status:         0,
 	})
 	// 拼装返回结果
 	if err != nil {
@@ -50,20 +86,28 @@ func (s *ReviewService) CreateReview(ctx context.Context, req *pb.CreateReviewRe
 func (s *ReviewService) GetReview(ctx context.Context, req *pb.GetReviewRequest) (*pb.GetReviewReply, error) {
 	fmt.Printf("GetReview req:%#v\n", req)
 	review, err := s.uc.GetReview(ctx, req.ReviewID)
-	return &pb.GetReviewReply{
-		Data: &pb.ReviewInfo{
-			ReviewID:     review.ReviewID,
-			UserID:       review.UserID,
-			OrderID:      review.OrderID,
-			Score:        review.Score,
-			ServiceScore: review.ServiceScore,
-			ExpressScore: review.ExpressScore,
-			Content:      review.Content,
-			PicInfo:      review.PicInfo,
-			VideoInfo:    review.VideoInfo,
-			Status:       review.Status,
-		},
-	}, err
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetReviewReply{Data: toReviewInfo(review)}, nil
+}
+
+// toReviewInfo 评价转换为接口返回的结构，创建时间使用评价者的本地时间
+func toReviewInfo(review *model.ReviewInfo) *pb.ReviewInfo {
+	return &pb.ReviewInfo{
+		ReviewID:       review.ReviewID,
+		UserID:         review.UserID,
+		OrderID:        review.OrderID,
+		Score:          review.Score,
+		ServiceScore:   review.ServiceScore,
+		ExpressScore:   review.ExpressScore,
+		Content:        review.Content,
+		PicInfo:        review.PicInfo,
+		VideoInfo:      review.VideoInfo,
+		Status:         review.Status,
+		TimezoneOffset: review.TimezoneOffset,
+		CreateAt:       convert.LocalTime(review).Format(time.RFC3339),
+	}
 }
 func (s *ReviewService) AuditReview(ctx context.Context, req *pb.AuditReviewRequest) (*pb.AuditReviewReply, error) {
 	fmt.Printf("AuditReview req:%#v\n", req)
@@ -105,6 +149,199 @@ func (s *ReviewService) AppealReview(ctx context.Context, req *pb.AppealReviewRe
 func (s *ReviewService) AuditAppeal(ctx context.Context, req *pb.AuditAppealRequest) (*pb.AuditAppealReply, error) {
 	return &pb.AuditAppealReply{}, nil
 }
+
+// ListReviewByUserID 分页查询用户的评价
 func (s *ReviewService) ListReviewByUserID(ctx context.Context, req *pb.ListReviewByUserIDRequest) (*pb.ListReviewByUserIDReply, error) {
-	return &pb.ListReviewByUserIDReply{}, nil
+	fmt.Printf("[service] ListReviewByUserID req:%#v\n", req)
+	reviews, err := s.uc.ListReviewByUserID(ctx, req.GetUserID(), int(req.GetPage()), int(req.GetSize()))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReviewInfo, 0, len(reviews))
+	for _, review := range reviews {
+		list = append(list, toReviewInfo(review))
+	}
+	return &pb.ListReviewByUserIDReply{List: list}, nil
+}
+
+// ListReviewByStoreID 按店铺和状态分页查询评价
+func (s *ReviewService) ListReviewByStoreID(ctx context.Context, req *pb.ListReviewByStoreIDRequest) (*pb.ListReviewByStoreIDReply, error) {
+	fmt.Printf("[service] ListReviewByStoreID req:%#v\n", req)
+	reviews, err := s.uc.ListReviewByStoreID(ctx, req.GetStoreID(), req.GetStatus(), int(req.GetPage()), int(req.GetSize()))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReviewInfo, 0, len(reviews))
+	for _, review := range reviews {
+		list = append(list, toReviewInfo(review))
+	}
+	return &pb.ListReviewByStoreIDReply{List: list}, nil
+}
+
+// BulkTagReviews 按条件批量给评价打标签
+func (s *ReviewService) BulkTagReviews(ctx context.Context, req *pb.BulkTagReviewsRequest) (*pb.BulkTagReviewsReply, error) {
+	fmt.Printf("[service] BulkTagReviews req:%#v\n", req)
+	count, err := s.uc.BulkTagReviews(ctx, biz.BulkFilter{
+		StoreID:  req.GetStoreID(),
+		Status:   req.GetStatus(),
+		MinScore: req.GetMinScore(),
+		MaxScore: req.GetMaxScore(),
+	}, req.GetTagID(), req.GetOpUser())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BulkTagReviewsReply{Count: count}, nil
+}
+
+// RequestWithdrawal 申请撤回评价
+func (s *ReviewService) RequestWithdrawal(ctx context.Context, req *pb.RequestWithdrawalRequest) (*pb.RequestWithdrawalReply, error) {
+	fmt.Printf("[service] RequestWithdrawal req:%#v\n", req)
+	withdrawal, err := s.uc.RequestWithdrawal(ctx, req.GetReviewID(), req.GetUserID(), req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RequestWithdrawalReply{WithdrawalID: withdrawal.WithdrawalID, Status: withdrawal.Status}, nil
+}
+
+// ApproveWithdrawal 同意撤回评价
+func (s *ReviewService) ApproveWithdrawal(ctx context.Context, req *pb.AuditWithdrawalRequest) (*pb.AuditWithdrawalReply, error) {
+	fmt.Printf("[service] ApproveWithdrawal req:%#v\n", req)
+	withdrawal, err := s.uc.ApproveWithdrawal(ctx, &biz.AuditWithdrawalParam{
+		WithdrawalID: req.GetWithdrawalID(),
+		OpUser:       req.GetOpUser(),
+		OpRemarks:    req.GetOpRemarks(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditWithdrawalReply{WithdrawalID: withdrawal.WithdrawalID, Status: withdrawal.Status}, nil
+}
+
+// DenyWithdrawal 拒绝撤回评价
+func (s *ReviewService) DenyWithdrawal(ctx context.Context, req *pb.AuditWithdrawalRequest) (*pb.AuditWithdrawalReply, error) {
+	fmt.Printf("[service] DenyWithdrawal req:%#v\n", req)
+	withdrawal, err := s.uc.DenyWithdrawal(ctx, &biz.AuditWithdrawalParam{
+		WithdrawalID: req.GetWithdrawalID(),
+		OpUser:       req.GetOpUser(),
+		OpRemarks:    req.GetOpRemarks(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditWithdrawalReply{WithdrawalID: withdrawal.WithdrawalID, Status: withdrawal.Status}, nil
+}
+
+// DequeueModeration 取出店铺待审核队列中最紧急的评价
+func (s *ReviewService) DequeueModeration(ctx context.Context, req *pb.DequeueModerationRequest) (*pb.DequeueModerationReply, error) {
+	fmt.Printf("[service] DequeueModeration req:%#v\n", req)
+	reviewID, err := s.queue.DequeueNextForModeration(ctx, req.GetStoreID())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DequeueModerationReply{ReviewID: reviewID}, nil
+}
+
+// GetModerationQueueDepth 查看店铺待审核队列的长度
+func (s *ReviewService) GetModerationQueueDepth(ctx context.Context, req *pb.GetModerationQueueDepthRequest) (*pb.GetModerationQueueDepthReply, error) {
+	depth, err := s.queue.GetQueueDepth(ctx, req.GetStoreID())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetModerationQueueDepthReply{Depth: depth}, nil
+}
+
+// BulkGetReviewStats 批量获取商品的评价统计
+func (s *ReviewService) BulkGetReviewStats(ctx context.Context, req *pb.BulkGetReviewStatsRequest) (*pb.BulkGetReviewStatsReply, error) {
+	stats, err := s.uc.BulkGetProductStats(ctx, req.GetProductIDs())
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[int64]*pb.ProductStat, len(stats))
+	for id, stat := range stats {
+		ret[id] = &pb.ProductStat{
+			AvgScore:            stat.AvgScore,
+			ReviewCount:         stat.ReviewCount,
+			HasVerifiedPurchase: stat.HasVerifiedPurchase,
+		}
+	}
+	return &pb.BulkGetReviewStatsReply{Stats: ret}, nil
+}
+
+// GetPlatformReport 全平台评价统计
+func (s *ReviewService) GetPlatformReport(ctx context.Context, req *pb.GetPlatformReportRequest) (*pb.GetPlatformReportReply, error) {
+	fmt.Printf("[service] GetPlatformReport req:%#v\n", req)
+	report, err := s.report.GetPlatformReport(ctx, time.Unix(req.GetFrom(), 0), time.Unix(req.GetTo(), 0))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetPlatformReportReply{
+		TotalReviews:    report.TotalReviews,
+		AvgScore:        report.AvgScore,
+		ApprovalRate:    report.ApprovalRate,
+		MostActiveStore: report.MostActiveStore,
+		DauReviewers:    report.DAUReviewers,
+		TopKeywords:     report.TopKeywords,
+	}, nil
+}
+
+// ListAnomalousReviews 店铺下异常分数高的评价
+func (s *ReviewService) ListAnomalousReviews(ctx context.Context, req *pb.ListAnomalousReviewsRequest) (*pb.ListAnomalousReviewsReply, error) {
+	fmt.Printf("[service] ListAnomalousReviews req:%#v\n", req)
+	reviews, err := s.uc.ListAnomalousReviews(ctx, req.GetStoreID(), req.GetThreshold())
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReviewInfo, 0, len(reviews))
+	for _, review := range reviews {
+		// 异常分只在O端的异常评价列表中返回
+		info := toReviewInfo(review)
+		info.AnomalyScore = review.AnomalyScore
+		list = append(list, info)
+	}
+	return &pb.ListAnomalousReviewsReply{List: list}, nil
+}
+
+// CompareReviewPeriods 对比店铺两个时间段的评价数据
+func (s *ReviewService) CompareReviewPeriods(ctx context.Context, req *pb.CompareReviewPeriodsRequest) (*pb.CompareReviewPeriodsReply, error) {
+	fmt.Printf("[service] CompareReviewPeriods req:%#v\n", req)
+	toDateRange := func(r *pb.DateRange) biz.DateRange {
+		return biz.DateRange{From: time.Unix(r.GetFrom(), 0), To: time.Unix(r.GetTo(), 0)}
+	}
+	ret, err := s.uc.CompareReviewPeriods(ctx, req.GetStoreID(), toDateRange(req.GetPeriodA()), toDateRange(req.GetPeriodB()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CompareReviewPeriodsReply{
+		PeriodA: &pb.PeriodStats{AvgScore: ret.PeriodA.AvgScore, ReviewCount: ret.PeriodA.ReviewCount},
+		PeriodB: &pb.PeriodStats{AvgScore: ret.PeriodB.AvgScore, ReviewCount: ret.PeriodB.ReviewCount},
+	}, nil
+}
+
+// ExportUserData 导出用户的个人数据
+func (s *ReviewService) ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.ExportUserDataReply, error) {
+	fmt.Printf("[service] ExportUserData req:%#v\n", req)
+	data, sig, err := s.compliance.SignedUserDataExport(ctx, req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExportUserDataReply{Data: data, Signature: sig}, nil
+}
+
+// DetectTrendingTags 店铺最近24小时的热门标签
+func (s *ReviewService) DetectTrendingTags(ctx context.Context, req *pb.DetectTrendingTagsRequest) (*pb.DetectTrendingTagsReply, error) {
+	tags, err := s.trend.DetectTrendingTags(ctx, req.GetStoreID(), int(req.GetTopN()))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.TrendingTag, 0, len(tags))
+	for _, t := range tags {
+		list = append(list, &pb.TrendingTag{
+			TagID:      t.Tag.TagID,
+			Name:       t.Tag.Name,
+			TodayCount: t.TodayCount,
+			WeeklyAvg:  t.WeeklyAvg,
+			TrendScore: t.TrendScore,
+		})
+	}
+	return &pb.DetectTrendingTagsReply{List: list}, nil
 }
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+)
+
+// StartReviewSession 开始分步填写评价
+func (s *ReviewService) StartReviewSession(ctx context.Context, req *pb.StartReviewSessionRequest) (*pb.StartReviewSessionReply, error) {
+	fmt.Printf("[service] StartReviewSession req:%#v\n", req)
+	sessionID, err := s.session.StartReviewSession(ctx, &biz.StartSessionParam{
+		UserID:  req.GetUserID(),
+		OrderID: req.GetOrderID(),
+		StoreID: req.GetStoreID(),
+		SpuID:   req.GetSpuID(),
+		SkuID:   req.GetSkuID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StartReviewSessionReply{SessionID: sessionID}, nil
+}
+
+// UpdateReviewSession 保存会话某一步填写的数据
+func (s *ReviewService) UpdateReviewSession(ctx context.Context, req *pb.UpdateReviewSessionRequest) (*pb.UpdateReviewSessionReply, error) {
+	fmt.Printf("[service] UpdateReviewSession req:%#v\n", req)
+	err := s.session.UpdateReviewSession(ctx, req.GetSessionID(), req.GetUserID(), int(req.GetStep()), req.GetData().AsMap())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateReviewSessionReply{}, nil
+}
+
+// CommitReviewSession 提交会话，创建评价
+func (s *ReviewService) CommitReviewSession(ctx context.Context, req *pb.CommitReviewSessionRequest) (*pb.CommitReviewSessionReply, error) {
+	fmt.Printf("[service] CommitReviewSession req:%#v\n", req)
+	timezoneOffset, err := timezoneOffsetFromHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	review, err := s.session.CommitReviewSession(ctx, req.GetSessionID(), req.GetUserID(), timezoneOffset)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CommitReviewSessionReply{ReviewID: review.ReviewID}, nil
+}
@@ -0,0 +1,163 @@
+package anomaly
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// 孤立森林(Isolation Forest)异常检测
+// 随机选特征、在特征取值范围内随机选切分点，不断切分样本直到样本被孤立；
+// 异常点通常很快就被孤立，平均路径长度越短越异常
+// 异常分数 s = 2^(-E(h(x)) / c(n))，取值[0,1]，越接近1越异常，0.5左右为正常
+
+const (
+	defaultTrees      = 100
+	defaultSampleSize = 256
+	// eulerGamma 欧拉常数，用于估算调和数
+	eulerGamma = 0.5772156649
+)
+
+// Option 配置项
+type Option func(f *Forest)
+
+// WithTrees 树的数量
+func WithTrees(n int) Option {
+	return func(f *Forest) { f.trees = n }
+}
+
+// WithSampleSize 每棵树的子采样数
+func WithSampleSize(n int) Option {
+	return func(f *Forest) { f.sampleSize = n }
+}
+
+// WithSeed 随机数种子，默认使用当前时间
+func WithSeed(seed int64) Option {
+	return func(f *Forest) { f.seed = seed }
+}
+
+// Forest 训练好的孤立森林，构建后只读，可以并发调用Detect
+type Forest struct {
+	trees      int
+	sampleSize int
+	seed       int64
+
+	roots []*node
+	// c 子采样数对应的平均路径长度，用于归一化
+	c float64
+}
+
+type node struct {
+	// 内部节点
+	feature     int
+	split       float64
+	left, right *node
+	// 叶子节点：落到该节点的样本数
+	size int
+}
+
+// NewForest 用samples训练孤立森林，每个样本的特征数必须相同
+func NewForest(samples [][]float64, opts ...Option) *Forest {
+	f := &Forest{
+		trees:      defaultTrees,
+		sampleSize: defaultSampleSize,
+		seed:       time.Now().UnixNano(),
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	if len(samples) == 0 {
+		return f
+	}
+	if f.sampleSize > len(samples) {
+		f.sampleSize = len(samples)
+	}
+	rnd := rand.New(rand.NewSource(f.seed))
+	maxDepth := int(math.Ceil(math.Log2(float64(f.sampleSize))))
+	f.c = averagePathLength(f.sampleSize)
+	f.roots = make([]*node, 0, f.trees)
+	for i := 0; i < f.trees; i++ {
+		f.roots = append(f.roots, buildTree(subsample(samples, f.sampleSize, rnd), 0, maxDepth, rnd))
+	}
+	return f
+}
+
+// Detect 计算异常分数，取值[0,1]，森林未训练时返回0
+func (f *Forest) Detect(features []float64) float64 {
+	if f == nil || len(f.roots) == 0 || f.c == 0 {
+		return 0
+	}
+	var sum float64
+	for _, root := range f.roots {
+		sum += pathLength(features, root, 0)
+	}
+	return math.Pow(2, -(sum/float64(len(f.roots)))/f.c)
+}
+
+// subsample 不放回随机抽取n个样本
+func subsample(samples [][]float64, n int, rnd *rand.Rand) [][]float64 {
+	idx := rnd.Perm(len(samples))[:n]
+	ret := make([][]float64, 0, n)
+	for _, i := range idx {
+		ret = append(ret, samples[i])
+	}
+	return ret
+}
+
+func buildTree(samples [][]float64, depth, maxDepth int, rnd *rand.Rand) *node {
+	if depth >= maxDepth || len(samples) <= 1 {
+		return &node{size: len(samples)}
+	}
+	// 随机选一个取值不全相同的特征
+	dims := len(samples[0])
+	for _, feature := range rnd.Perm(dims) {
+		lo, hi := samples[0][feature], samples[0][feature]
+		for _, s := range samples[1:] {
+			lo = math.Min(lo, s[feature])
+			hi = math.Max(hi, s[feature])
+		}
+		if lo == hi {
+			continue
+		}
+		split := lo + rnd.Float64()*(hi-lo)
+		var left, right [][]float64
+		for _, s := range samples {
+			if s[feature] < split {
+				left = append(left, s)
+			} else {
+				right = append(right, s)
+			}
+		}
+		return &node{
+			feature: feature,
+			split:   split,
+			left:    buildTree(left, depth+1, maxDepth, rnd),
+			right:   buildTree(right, depth+1, maxDepth, rnd),
+		}
+	}
+	// 所有样本完全相同，无法再切分
+	return &node{size: len(samples)}
+}
+
+func pathLength(x []float64, n *node, depth int) float64 {
+	if n.left == nil {
+		// 叶子节点上还没孤立的样本按平均路径长度补上
+		return float64(depth) + averagePathLength(n.size)
+	}
+	if n.feature < len(x) && x[n.feature] < n.split {
+		return pathLength(x, n.left, depth+1)
+	}
+	return pathLength(x, n.right, depth+1)
+}
+
+// averagePathLength n个样本的二叉搜索树查找失败的平均路径长度 c(n)
+func averagePathLength(n int) float64 {
+	switch {
+	case n <= 1:
+		return 0
+	case n == 2:
+		return 1
+	}
+	fn := float64(n)
+	return 2*(math.Log(fn-1)+eulerGamma) - 2*(fn-1)/fn
+}
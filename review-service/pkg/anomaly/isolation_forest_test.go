@@ -0,0 +1,54 @@
+package anomaly
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// inliers 围绕(3, 100, 12)正态分布的正常样本：评分、内容长度、提交时刻
+func inliers(n int, rnd *rand.Rand) [][]float64 {
+	samples := make([][]float64, 0, n)
+	for i := 0; i < n; i++ {
+		samples = append(samples, []float64{
+			3 + rnd.NormFloat64()*0.5,
+			100 + rnd.NormFloat64()*10,
+			12 + rnd.NormFloat64()*2,
+		})
+	}
+	return samples
+}
+
+func TestDetectOutlier(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	samples := inliers(256, rnd)
+	forest := NewForest(samples, WithSeed(42))
+
+	outlier := forest.Detect([]float64{1, 2000, 3})
+	if outlier < 0 || outlier > 1 {
+		t.Fatalf("outlier score %v out of [0, 1]", outlier)
+	}
+	for _, x := range inliers(20, rnd) {
+		if inlier := forest.Detect(x); inlier >= outlier {
+			t.Fatalf("inlier %v scored %v, not below outlier score %v", x, inlier, outlier)
+		}
+	}
+	if outlier < 0.6 {
+		t.Fatalf("outlier score %v, want >= 0.6", outlier)
+	}
+}
+
+func TestDetectSeedDeterministic(t *testing.T) {
+	samples := inliers(256, rand.New(rand.NewSource(1)))
+	a := NewForest(samples, WithSeed(7))
+	b := NewForest(samples, WithSeed(7))
+	x := []float64{1, 2000, 3}
+	if sa, sb := a.Detect(x), b.Detect(x); sa != sb {
+		t.Fatalf("same seed gave different scores: %v vs %v", sa, sb)
+	}
+}
+
+func TestDetectUntrained(t *testing.T) {
+	if score := NewForest(nil).Detect([]float64{1, 2, 3}); score != 0 {
+		t.Fatalf("untrained forest score = %v, want 0", score)
+	}
+}
@@ -0,0 +1,78 @@
+package budget
+
+import (
+	"context"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 一次请求内下游调用的耗时预算
+// 请求入口的Server中间件用NewBudgetContext分配总预算，下游调用通过Call在剩余预算内执行并扣除实际耗时，
+// 预算用完后后续调用直接返回ErrBudgetExceeded，避免串行调用累计超时
+
+// ErrBudgetExceeded 耗时预算已用完
+var ErrBudgetExceeded = errors.GatewayTimeout("LATENCY_BUDGET_EXCEEDED", "下游调用耗时超出预算")
+
+type budgetKey struct{}
+
+type latencyBudget struct {
+	remaining atomic.Int64
+}
+
+// NewBudgetContext 返回携带总耗时预算total的ctx
+func NewBudgetContext(ctx context.Context, total time.Duration) context.Context {
+	b := new(latencyBudget)
+	b.remaining.Store(int64(total))
+	return context.WithValue(ctx, budgetKey{}, b)
+}
+
+// Consume 从预算中扣除d，返回剩余预算
+// 扣除后剩余预算小于等于0时返回ErrBudgetExceeded；ctx中没有预算时视为不限制
+func Consume(ctx context.Context, d time.Duration) (time.Duration, error) {
+	b, ok := ctx.Value(budgetKey{}).(*latencyBudget)
+	if !ok {
+		return time.Duration(math.MaxInt64), nil
+	}
+	remaining := time.Duration(b.remaining.Add(-int64(d)))
+	if remaining <= 0 {
+		return 0, ErrBudgetExceeded
+	}
+	return remaining, nil
+}
+
+// Call 在剩余预算内执行一次下游调用并扣除其耗时，预算已用完时不再发起调用
+// fn的ctx在剩余预算用完时取消，慢调用不会拖过整个请求的预算，因预算取消时返回ErrBudgetExceeded
+// 调用已经完成（比如写入已提交）时返回fn的结果，超出的耗时由后续调用承担
+func Call(ctx context.Context, fn func(ctx context.Context) error) error {
+	remaining, ok := Remaining(ctx)
+	if !ok {
+		return fn(ctx)
+	}
+	if remaining <= 0 {
+		return ErrBudgetExceeded
+	}
+	callCtx, cancel := context.WithTimeout(ctx, remaining)
+	defer cancel()
+	start := time.Now()
+	err := fn(callCtx)
+	Consume(ctx, time.Since(start))
+	if err != nil && ctx.Err() == nil && callCtx.Err() == context.DeadlineExceeded {
+		return ErrBudgetExceeded
+	}
+	return err
+}
+
+// Remaining 返回剩余预算，ctx中没有预算时ok为false
+func Remaining(ctx context.Context) (remaining time.Duration, ok bool) {
+	b, ok := ctx.Value(budgetKey{}).(*latencyBudget)
+	if !ok {
+		return 0, false
+	}
+	if remaining = time.Duration(b.remaining.Load()); remaining < 0 {
+		remaining = 0
+	}
+	return remaining, true
+}
@@ -0,0 +1,92 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestConsumeExceeded 200ms的预算分3次用完后，第4次调用直接返回ErrBudgetExceeded
+func TestConsumeExceeded(t *testing.T) {
+	ctx := NewBudgetContext(context.Background(), 200*time.Millisecond)
+	for i, d := range []time.Duration{50 * time.Millisecond, 50 * time.Millisecond, 100 * time.Millisecond} {
+		remaining, err := Consume(ctx, d)
+		if i < 2 && err != nil {
+			t.Fatalf("call %d: unexpected err:%v", i+1, err)
+		}
+		if i == 2 && !errors.Is(err, ErrBudgetExceeded) {
+			t.Fatalf("call %d: err=%v remaining=%v, want ErrBudgetExceeded", i+1, err, remaining)
+		}
+	}
+
+	called := false
+	err := Call(ctx, func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+	if !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("4th call err=%v, want ErrBudgetExceeded", err)
+	}
+	if called {
+		t.Fatal("4th call should not be dispatched")
+	}
+}
+
+// TestCallCompletedNotOverridden 调用完成后即使超出预算也返回调用本身的结果
+func TestCallCompletedNotOverridden(t *testing.T) {
+	ctx := NewBudgetContext(context.Background(), time.Millisecond)
+	err := Call(ctx, func(ctx context.Context) error {
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("completed call err=%v, want nil", err)
+	}
+	if remaining, ok := Remaining(ctx); !ok || remaining != 0 {
+		t.Fatalf("remaining=%v ok=%v, want 0 true", remaining, ok)
+	}
+}
+
+func TestConsumeWithoutBudget(t *testing.T) {
+	if _, err := Consume(context.Background(), time.Hour); err != nil {
+		t.Fatalf("unexpected err:%v", err)
+	}
+}
+
+// TestCallCancelledMidCall 调用超过剩余预算时ctx被取消，返回ErrBudgetExceeded
+func TestCallCancelledMidCall(t *testing.T) {
+	ctx := NewBudgetContext(context.Background(), 20*time.Millisecond)
+	start := time.Now()
+	err := Call(ctx, func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+			return nil
+		}
+	})
+	if !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("slow call err=%v, want ErrBudgetExceeded", err)
+	}
+	if cost := time.Since(start); cost > 500*time.Millisecond {
+		t.Fatalf("slow call returned after %v, want it cancelled at the budget", cost)
+	}
+	if remaining, _ := Remaining(ctx); remaining != 0 {
+		t.Fatalf("remaining=%v, want 0", remaining)
+	}
+}
+
+// TestCallParentCancelled 请求本身被取消时返回fn的错误，不算作预算超出
+func TestCallParentCancelled(t *testing.T) {
+	parent, cancel := context.WithCancel(context.Background())
+	ctx := NewBudgetContext(parent, time.Second)
+	cancel()
+	err := Call(ctx, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err=%v, want context.Canceled", err)
+	}
+}
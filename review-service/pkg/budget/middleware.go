@@ -0,0 +1,20 @@
+package budget
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// Server 在请求入口为每个请求分配总耗时预算total，total<=0时不设置预算
+func Server(total time.Duration) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if total > 0 {
+				ctx = NewBudgetContext(ctx, total)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
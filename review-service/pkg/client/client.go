@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	v1 "review-service/api/review/v1"
+	"review-service/pkg/middleware"
+
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	ggrpc "google.golang.org/grpc"
+	grpcmd "google.golang.org/grpc/metadata"
+)
+
+// 直连评价服务的gRPC客户端，供运维工具等不走服务发现的场景使用
+
+// AdminKeyHeader 管理接口鉴权用的metadata key
+const AdminKeyHeader = middleware.AdminKeyHeader
+
+// Config 客户端配置
+type Config struct {
+	// Addr 评价服务gRPC地址，如127.0.0.1:9000
+	Addr string
+	// AdminKey 不为空时随每个请求发送
+	AdminKey string
+	// TLSCert 服务端证书（或CA证书）路径，为空时使用明文连接
+	TLSCert string
+}
+
+// NewReviewClient 创建评价服务客户端，返回的函数用于关闭连接
+func NewReviewClient(ctx context.Context, c Config) (v1.ReviewClient, func(), error) {
+	opts := []grpc.ClientOption{
+		grpc.WithEndpoint(c.Addr),
+		grpc.WithMiddleware(recovery.Recovery()),
+	}
+	if c.AdminKey != "" {
+		opts = append(opts, grpc.WithUnaryInterceptor(adminKeyInterceptor(c.AdminKey)))
+	}
+	var (
+		conn *ggrpc.ClientConn
+		err  error
+	)
+	if c.TLSCert != "" {
+		tlsConf, terr := loadTLSConfig(c.TLSCert)
+		if terr != nil {
+			return nil, nil, terr
+		}
+		conn, err = grpc.Dial(ctx, append(opts, grpc.WithTLSConfig(tlsConf))...)
+	} else {
+		conn, err = grpc.DialInsecure(ctx, opts...)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return v1.NewReviewClient(conn), func() { conn.Close() }, nil
+}
+
+func loadTLSConfig(certFile string) (*tls.Config, error) {
+	pem, err := os.ReadFile(certFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("client: no certificate found in %s", certFile)
+	}
+	return &tls.Config{RootCAs: pool}, nil
+}
+
+func adminKeyInterceptor(key string) ggrpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *ggrpc.ClientConn, invoker ggrpc.UnaryInvoker, opts ...ggrpc.CallOption) error {
+		ctx = grpcmd.AppendToOutgoingContext(ctx, AdminKeyHeader, key)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
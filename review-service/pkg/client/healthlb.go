@@ -0,0 +1,334 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/selector"
+	"github.com/go-kratos/kratos/v2/selector/node/direct"
+	"github.com/go-kratos/kratos/v2/selector/wrr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// 基于gRPC健康检查的负载均衡
+// 后台定期对每个节点调用grpc.health.v1.Health/Check，返回NOT_SERVING（或检查失败）的节点立即摘除，
+// 摘除的节点连续两次检查为SERVING后才重新加入。所有节点都被摘除时退化为使用全部节点，避免整体不可用。
+// 同一个构造器Build出的所有负载均衡器共用一个后台检查和检查用的连接，客户端关闭时调用返回的cleanup停止检查。
+//
+// 用法：
+//
+//	builder, cleanup := client.NewHealthBuilder(client.WithTLSConfig(tlsConf))
+//	defer cleanup()
+//	selector.SetGlobalSelector(builder)
+
+const (
+	defaultCheckInterval = 5 * time.Second
+	defaultCheckTimeout  = time.Second
+	// recoverThreshold 摘除的节点恢复所需的连续SERVING次数
+	recoverThreshold = 2
+)
+
+// HealthOption 健康检查负载均衡的配置项
+type HealthOption func(o *healthOptions)
+
+type healthOptions struct {
+	interval    time.Duration
+	timeout     time.Duration
+	service     string
+	balancer    selector.BalancerBuilder
+	tlsConf     *tls.Config
+	dialOptions []grpc.DialOption
+}
+
+// WithCheckInterval 健康检查间隔
+func WithCheckInterval(d time.Duration) HealthOption {
+	return func(o *healthOptions) { o.interval = d }
+}
+
+// WithCheckTimeout 单次健康检查超时时间
+func WithCheckTimeout(d time.Duration) HealthOption {
+	return func(o *healthOptions) { o.timeout = d }
+}
+
+// WithHealthService 健康检查的服务名，默认为空表示整个server
+func WithHealthService(name string) HealthOption {
+	return func(o *healthOptions) { o.service = name }
+}
+
+// WithBalancer 过滤掉不健康节点后实际使用的负载均衡算法，默认wrr
+func WithBalancer(b selector.BalancerBuilder) HealthOption {
+	return func(o *healthOptions) { o.balancer = b }
+}
+
+// WithTLSConfig 健康检查连接使用的TLS配置，应与客户端的grpc.WithTLSConfig相同，为空时使用明文连接
+func WithTLSConfig(c *tls.Config) HealthOption {
+	return func(o *healthOptions) { o.tlsConf = c }
+}
+
+// WithDialOptions 健康检查连接额外的拨号参数
+func WithDialOptions(opts ...grpc.DialOption) HealthOption {
+	return func(o *healthOptions) { o.dialOptions = append(o.dialOptions, opts...) }
+}
+
+// NewHealthBuilder 返回带健康检查的selector构造器，返回的函数用于停止健康检查并关闭检查用的连接
+func NewHealthBuilder(opts ...HealthOption) (selector.Builder, func()) {
+	b := NewHealthBalancerBuilder(opts...)
+	return &selector.DefaultBuilder{
+		Balancer: b,
+		Node:     &direct.Builder{},
+	}, b.Close
+}
+
+// HealthBalancerBuilder 健康检查负载均衡构造器
+type HealthBalancerBuilder struct {
+	checker *healthChecker
+}
+
+// NewHealthBalancerBuilder 创建构造器并启动后台健康检查，不再使用时调用Close
+func NewHealthBalancerBuilder(opts ...HealthOption) *HealthBalancerBuilder {
+	o := healthOptions{
+		interval: defaultCheckInterval,
+		timeout:  defaultCheckTimeout,
+		balancer: &wrr.Builder{},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	c := &healthChecker{
+		opts:    o,
+		targets: make(map[string]*target),
+		stop:    make(chan struct{}),
+	}
+	go c.loop()
+	return &HealthBalancerBuilder{checker: c}
+}
+
+// Build 创建使用共享健康检查的负载均衡器，不会启动新的goroutine
+func (b *HealthBalancerBuilder) Build() selector.Balancer {
+	return &HealthBalancer{
+		checker: b.checker,
+		inner:   b.checker.opts.balancer.Build(),
+		addrs:   make(map[string]struct{}),
+	}
+}
+
+// Close 停止健康检查并关闭检查用的连接
+func (b *HealthBalancerBuilder) Close() {
+	b.checker.close()
+}
+
+// HealthBalancer 过滤掉不健康节点后再交给内部负载均衡器选择
+type HealthBalancer struct {
+	checker *healthChecker
+	inner   selector.Balancer
+
+	mu sync.Mutex
+	// addrs 当前负载均衡器引用的节点
+	addrs map[string]struct{}
+}
+
+// Pick 从健康节点中选择一个
+func (b *HealthBalancer) Pick(ctx context.Context, nodes []selector.WeightedNode) (selector.WeightedNode, selector.DoneFunc, error) {
+	if len(nodes) == 0 {
+		return nil, nil, selector.ErrNoAvailable
+	}
+	b.sync(nodes)
+	candidates := make([]selector.WeightedNode, 0, len(nodes))
+	for _, n := range nodes {
+		if b.checker.healthy(n.Address()) {
+			candidates = append(candidates, n)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = nodes
+	}
+	return b.inner.Pick(ctx, candidates)
+}
+
+// sync 开始检查新出现的节点，释放已经不在服务发现结果里的节点
+func (b *HealthBalancer) sync(nodes []selector.WeightedNode) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	alive := make(map[string]struct{}, len(nodes))
+	for _, n := range nodes {
+		addr := n.Address()
+		alive[addr] = struct{}{}
+		if _, ok := b.addrs[addr]; !ok {
+			b.addrs[addr] = struct{}{}
+			b.checker.acquire(addr)
+		}
+	}
+	for addr := range b.addrs {
+		if _, ok := alive[addr]; !ok {
+			delete(b.addrs, addr)
+			b.checker.release(addr)
+		}
+	}
+}
+
+// target 一个节点的健康状态
+type target struct {
+	conn    *grpc.ClientConn
+	refs    int // 引用该节点的负载均衡器数
+	healthy bool
+	serving int // 摘除之后连续SERVING的次数
+}
+
+// healthChecker 构造器内共享的健康检查，节点没有负载均衡器引用后关闭连接
+type healthChecker struct {
+	opts healthOptions
+
+	mu      sync.RWMutex
+	targets map[string]*target
+	closed  bool
+
+	stopOnce sync.Once
+	stop     chan struct{}
+}
+
+// healthy 没有检查过的节点默认健康，等待下一轮检查
+func (c *healthChecker) healthy(addr string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	t, ok := c.targets[addr]
+	return !ok || t.healthy
+}
+
+func (c *healthChecker) acquire(addr string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return
+	}
+	if t, ok := c.targets[addr]; ok {
+		t.refs++
+		return
+	}
+	conn, err := grpc.Dial(addr, c.dialOptions()...)
+	if err != nil {
+		log.Errorf("healthlb dial %s fail, err:%v", addr, err)
+	}
+	c.targets[addr] = &target{conn: conn, refs: 1, healthy: true}
+}
+
+func (c *healthChecker) release(addr string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t, ok := c.targets[addr]
+	if !ok {
+		return
+	}
+	if t.refs--; t.refs > 0 {
+		return
+	}
+	if t.conn != nil {
+		t.conn.Close()
+	}
+	delete(c.targets, addr)
+}
+
+// dialOptions 健康检查连接与业务连接使用相同的传输层凭证
+func (c *healthChecker) dialOptions() []grpc.DialOption {
+	creds := insecure.NewCredentials()
+	if c.opts.tlsConf != nil {
+		creds = credentials.NewTLS(c.opts.tlsConf)
+	}
+	return append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, c.opts.dialOptions...)
+}
+
+func (c *healthChecker) close() {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.closed = true
+		for addr, t := range c.targets {
+			if t.conn != nil {
+				t.conn.Close()
+			}
+			delete(c.targets, addr)
+		}
+	})
+}
+
+func (c *healthChecker) loop() {
+	ticker := time.NewTicker(c.opts.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			c.checkAll()
+		}
+	}
+}
+
+func (c *healthChecker) checkAll() {
+	c.mu.RLock()
+	conns := make(map[string]*grpc.ClientConn, len(c.targets))
+	for addr, t := range c.targets {
+		conns[addr] = t.conn
+	}
+	c.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	results := make(map[string]bool, len(conns))
+	var rmu sync.Mutex
+	for addr, conn := range conns {
+		wg.Add(1)
+		go func(addr string, conn *grpc.ClientConn) {
+			defer wg.Done()
+			serving := c.check(conn)
+			rmu.Lock()
+			results[addr] = serving
+			rmu.Unlock()
+		}(addr, conn)
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for addr, serving := range results {
+		t, ok := c.targets[addr]
+		if !ok || t.conn != conns[addr] {
+			// 检查期间节点被释放或者重新加入
+			continue
+		}
+		switch {
+		case !serving:
+			if t.healthy {
+				log.Warnf("healthlb remove unhealthy endpoint %s", addr)
+			}
+			t.healthy = false
+			t.serving = 0
+		case !t.healthy:
+			t.serving++
+			if t.serving >= recoverThreshold {
+				log.Infof("healthlb endpoint %s recovered", addr)
+				t.healthy = true
+				t.serving = 0
+			}
+		}
+	}
+}
+
+// check 调用健康检查接口，只有返回SERVING才认为健康
+func (c *healthChecker) check(conn *grpc.ClientConn) bool {
+	if conn == nil {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.opts.timeout)
+	defer cancel()
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: c.opts.service})
+	if err != nil {
+		return false
+	}
+	return resp.GetStatus() == grpc_health_v1.HealthCheckResponse_SERVING
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"review-service/pkg/client/testutil"
+
+	"github.com/go-kratos/kratos/v2/selector"
+	"github.com/go-kratos/kratos/v2/selector/node/direct"
+	"google.golang.org/grpc"
+)
+
+// pickCounts 连续选择n次，返回每个节点被选中的次数
+func pickCounts(t *testing.T, b selector.Balancer, nodes []selector.WeightedNode, n int) map[string]int {
+	t.Helper()
+	counts := make(map[string]int)
+	for i := 0; i < n; i++ {
+		node, done, err := b.Pick(context.Background(), nodes)
+		if err != nil {
+			t.Fatalf("Pick fail, err:%v", err)
+		}
+		done(context.Background(), selector.DoneInfo{})
+		counts[node.Address()]++
+	}
+	return counts
+}
+
+// waitFor 轮询直到cond成立，超时后失败
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestHealthBalancerSkipsUnhealthyNode(t *testing.T) {
+	srv := testutil.NewFakeServer().Build()
+	healthy := srv.Listen(t, "node-a")
+	unhealthy := srv.Listen(t, "node-b")
+	unhealthy.SetServing(false)
+
+	builder := NewHealthBalancerBuilder(
+		WithCheckInterval(10*time.Millisecond),
+		WithDialOptions(grpc.WithContextDialer(testutil.Dialer(healthy, unhealthy))),
+	)
+	defer builder.Close()
+	b := builder.Build()
+	nodeBuilder := &direct.Builder{}
+	nodes := []selector.WeightedNode{
+		nodeBuilder.Build(selector.NewNode("grpc", healthy.Addr, nil)),
+		nodeBuilder.Build(selector.NewNode("grpc", unhealthy.Addr, nil)),
+	}
+
+	// 第一次选择时开始检查，检查前两个节点都可用
+	pickCounts(t, b, nodes, 1)
+	waitFor(t, "node-b removed", func() bool { return pickCounts(t, b, nodes, 20)[unhealthy.Addr] == 0 })
+
+	// 恢复后连续两次SERVING才重新加入
+	unhealthy.SetServing(true)
+	waitFor(t, "node-b re-added", func() bool { return pickCounts(t, b, nodes, 20)[unhealthy.Addr] > 0 })
+
+	// 所有节点都不健康时使用全部节点
+	healthy.SetServing(false)
+	unhealthy.SetServing(false)
+	waitFor(t, "all nodes unhealthy", func() bool {
+		return !builder.checker.healthy(healthy.Addr) && !builder.checker.healthy(unhealthy.Addr)
+	})
+	if counts := pickCounts(t, b, nodes, 20); counts[healthy.Addr]+counts[unhealthy.Addr] != 20 {
+		t.Fatalf("picks = %v, want all nodes used", counts)
+	}
+}
+
+// TestHealthBalancerSharedChecker 同一个构造器的负载均衡器共用检查连接，节点不再被引用时关闭连接
+func TestHealthBalancerSharedChecker(t *testing.T) {
+	srv := testutil.NewFakeServer().Build()
+	e := srv.Listen(t, "node-a")
+	builder := NewHealthBalancerBuilder(WithDialOptions(grpc.WithContextDialer(testutil.Dialer(e))))
+	nodes := []selector.WeightedNode{(&direct.Builder{}).Build(selector.NewNode("grpc", e.Addr, nil))}
+
+	b1, b2 := builder.Build(), builder.Build()
+	pickCounts(t, b1, nodes, 1)
+	pickCounts(t, b2, nodes, 1)
+	targetCount := func() (int, int) {
+		builder.checker.mu.RLock()
+		defer builder.checker.mu.RUnlock()
+		if tg, ok := builder.checker.targets[e.Addr]; ok {
+			return len(builder.checker.targets), tg.refs
+		}
+		return len(builder.checker.targets), 0
+	}
+	if n, refs := targetCount(); n != 1 || refs != 2 {
+		t.Fatalf("targets=%d refs=%d, want one shared target with 2 refs", n, refs)
+	}
+
+	// 节点从服务发现结果中下线
+	other := []selector.WeightedNode{(&direct.Builder{}).Build(selector.NewNode("grpc", "node-gone", nil))}
+	pickCounts(t, b1, other, 1)
+	if _, refs := targetCount(); refs != 1 {
+		t.Fatalf("refs=%d after b1 dropped the node, want 1", refs)
+	}
+
+	builder.Close()
+	if n, _ := targetCount(); n != 0 {
+		t.Fatalf("targets=%d after Close, want 0", n)
+	}
+	// 关闭后不再建立新的检查连接
+	pickCounts(t, b2, nodes, 1)
+	if n, _ := targetCount(); n != 0 {
+		t.Fatalf("targets=%d after Pick on a closed builder, want 0", n)
+	}
+}
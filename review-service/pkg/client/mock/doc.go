@@ -0,0 +1,4 @@
+// Package mock 评价服务gRPC客户端的mock实现，供依赖评价服务的下游服务在单元测试中使用
+package mock
+
+//go:generate mockgen -source=../../../api/review/v1/review_grpc.pb.go -destination=mock_review_client.go -package=mock
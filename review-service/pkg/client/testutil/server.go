@@ -0,0 +1,225 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	v1 "review-service/api/review/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// 依赖评价服务的下游服务在测试中使用的假评价服务
+// 通过builder配置各接口的返回值，用bufconn在内存中启动gRPC服务，不需要真正监听端口
+//
+//	client := testutil.NewFakeServer().
+//		WithCreateReviewReturning(&v1.CreateReviewReply{ReviewID: 1}).
+//		Build().
+//		Start(t)
+
+const bufSize = 1 << 20
+
+// FakeServerBuilder 假评价服务构造器
+type FakeServerBuilder struct {
+	srv *FakeReviewServer
+}
+
+// NewFakeServer .
+func NewFakeServer() *FakeServerBuilder {
+	return &FakeServerBuilder{srv: &FakeReviewServer{}}
+}
+
+// WithCreateReviewReturning CreateReview返回reply
+func (b *FakeServerBuilder) WithCreateReviewReturning(reply *v1.CreateReviewReply) *FakeServerBuilder {
+	b.srv.createReviewReply, b.srv.createReviewErr = reply, nil
+	return b
+}
+
+// WithCreateReviewError CreateReview返回err
+func (b *FakeServerBuilder) WithCreateReviewError(err error) *FakeServerBuilder {
+	b.srv.createReviewReply, b.srv.createReviewErr = nil, err
+	return b
+}
+
+// WithGetReviewReturning GetReview返回reply
+func (b *FakeServerBuilder) WithGetReviewReturning(reply *v1.GetReviewReply) *FakeServerBuilder {
+	b.srv.getReviewReply, b.srv.getReviewErr = reply, nil
+	return b
+}
+
+// WithGetReviewError GetReview返回err
+func (b *FakeServerBuilder) WithGetReviewError(err error) *FakeServerBuilder {
+	b.srv.getReviewReply, b.srv.getReviewErr = nil, err
+	return b
+}
+
+// WithListReviewByUserIDReturning ListReviewByUserID返回reply
+func (b *FakeServerBuilder) WithListReviewByUserIDReturning(reply *v1.ListReviewByUserIDReply) *FakeServerBuilder {
+	b.srv.listReviewByUserIDReply, b.srv.listReviewByUserIDErr = reply, nil
+	return b
+}
+
+// WithListReviewByStoreIDReturning ListReviewByStoreID返回reply
+func (b *FakeServerBuilder) WithListReviewByStoreIDReturning(reply *v1.ListReviewByStoreIDReply) *FakeServerBuilder {
+	b.srv.listReviewByStoreIDReply, b.srv.listReviewByStoreIDErr = reply, nil
+	return b
+}
+
+// Build .
+func (b *FakeServerBuilder) Build() *FakeReviewServer {
+	return b.srv
+}
+
+// FakeReviewServer 按配置返回固定结果的评价服务，未配置的接口返回Unimplemented
+type FakeReviewServer struct {
+	v1.UnimplementedReviewServer
+
+	createReviewReply        *v1.CreateReviewReply
+	createReviewErr          error
+	getReviewReply           *v1.GetReviewReply
+	getReviewErr             error
+	listReviewByUserIDReply  *v1.ListReviewByUserIDReply
+	listReviewByUserIDErr    error
+	listReviewByStoreIDReply *v1.ListReviewByStoreIDReply
+	listReviewByStoreIDErr   error
+
+	mu                       sync.Mutex
+	createReviewCalls        []*v1.CreateReviewRequest
+	getReviewCalls           []*v1.GetReviewRequest
+	listReviewByUserIDCalls  []*v1.ListReviewByUserIDRequest
+	listReviewByStoreIDCalls []*v1.ListReviewByStoreIDRequest
+}
+
+func (s *FakeReviewServer) CreateReview(ctx context.Context, req *v1.CreateReviewRequest) (*v1.CreateReviewReply, error) {
+	s.mu.Lock()
+	s.createReviewCalls = append(s.createReviewCalls, req)
+	s.mu.Unlock()
+	if s.createReviewReply == nil && s.createReviewErr == nil {
+		return s.UnimplementedReviewServer.CreateReview(ctx, req)
+	}
+	return s.createReviewReply, s.createReviewErr
+}
+
+func (s *FakeReviewServer) GetReview(ctx context.Context, req *v1.GetReviewRequest) (*v1.GetReviewReply, error) {
+	s.mu.Lock()
+	s.getReviewCalls = append(s.getReviewCalls, req)
+	s.mu.Unlock()
+	if s.getReviewReply == nil && s.getReviewErr == nil {
+		return s.UnimplementedReviewServer.GetReview(ctx, req)
+	}
+	return s.getReviewReply, s.getReviewErr
+}
+
+func (s *FakeReviewServer) ListReviewByUserID(ctx context.Context, req *v1.ListReviewByUserIDRequest) (*v1.ListReviewByUserIDReply, error) {
+	s.mu.Lock()
+	s.listReviewByUserIDCalls = append(s.listReviewByUserIDCalls, req)
+	s.mu.Unlock()
+	if s.listReviewByUserIDReply == nil && s.listReviewByUserIDErr == nil {
+		return s.UnimplementedReviewServer.ListReviewByUserID(ctx, req)
+	}
+	return s.listReviewByUserIDReply, s.listReviewByUserIDErr
+}
+
+func (s *FakeReviewServer) ListReviewByStoreID(ctx context.Context, req *v1.ListReviewByStoreIDRequest) (*v1.ListReviewByStoreIDReply, error) {
+	s.mu.Lock()
+	s.listReviewByStoreIDCalls = append(s.listReviewByStoreIDCalls, req)
+	s.mu.Unlock()
+	if s.listReviewByStoreIDReply == nil && s.listReviewByStoreIDErr == nil {
+		return s.UnimplementedReviewServer.ListReviewByStoreID(ctx, req)
+	}
+	return s.listReviewByStoreIDReply, s.listReviewByStoreIDErr
+}
+
+// CreateReviewCalls 返回收到的CreateReview请求
+func (s *FakeReviewServer) CreateReviewCalls() []*v1.CreateReviewRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*v1.CreateReviewRequest(nil), s.createReviewCalls...)
+}
+
+// GetReviewCalls 返回收到的GetReview请求
+func (s *FakeReviewServer) GetReviewCalls() []*v1.GetReviewRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*v1.GetReviewRequest(nil), s.getReviewCalls...)
+}
+
+// ListReviewByUserIDCalls 返回收到的ListReviewByUserID请求
+func (s *FakeReviewServer) ListReviewByUserIDCalls() []*v1.ListReviewByUserIDRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*v1.ListReviewByUserIDRequest(nil), s.listReviewByUserIDCalls...)
+}
+
+// ListReviewByStoreIDCalls 返回收到的ListReviewByStoreID请求
+func (s *FakeReviewServer) ListReviewByStoreIDCalls() []*v1.ListReviewByStoreIDRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*v1.ListReviewByStoreIDRequest(nil), s.listReviewByStoreIDCalls...)
+}
+
+// Start 在bufconn上启动服务并返回连到该服务的客户端，测试结束时自动关闭
+func (s *FakeReviewServer) Start(t testing.TB) v1.ReviewClient {
+	t.Helper()
+	e := s.Listen(t, "bufnet")
+	conn, err := grpc.DialContext(context.Background(), e.Addr,
+		grpc.WithContextDialer(Dialer(e)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("testutil: dial bufconn fail, err:%v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return v1.NewReviewClient(conn)
+}
+
+// Endpoint bufconn上运行的一个服务节点，带有gRPC健康检查服务，初始状态为SERVING
+type Endpoint struct {
+	Addr   string
+	lis    *bufconn.Listener
+	health *health.Server
+}
+
+// Listen 在bufconn上以addr为地址启动服务节点，测试结束时自动关闭
+// 同一个FakeReviewServer可以启动多个节点，模拟多实例部署
+func (s *FakeReviewServer) Listen(t testing.TB, addr string) *Endpoint {
+	t.Helper()
+	e := &Endpoint{Addr: addr, lis: bufconn.Listen(bufSize), health: health.NewServer()}
+	srv := grpc.NewServer()
+	v1.RegisterReviewServer(srv, s)
+	grpc_health_v1.RegisterHealthServer(srv, e.health)
+	go srv.Serve(e.lis)
+	t.Cleanup(srv.Stop)
+	return e
+}
+
+// SetServing 切换节点的健康检查状态
+func (e *Endpoint) SetServing(serving bool) {
+	status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	e.health.SetServingStatus("", status)
+}
+
+// Dialer 按地址连接endpoints中的节点，用于grpc.WithContextDialer
+func Dialer(endpoints ...*Endpoint) func(ctx context.Context, addr string) (net.Conn, error) {
+	byAddr := make(map[string]*Endpoint, len(endpoints))
+	for _, e := range endpoints {
+		byAddr[e.Addr] = e
+	}
+	return func(ctx context.Context, addr string) (net.Conn, error) {
+		e, ok := byAddr[addr]
+		if !ok {
+			return nil, fmt.Errorf("testutil: unknown endpoint %s", addr)
+		}
+		return e.lis.DialContext(ctx)
+	}
+}
@@ -0,0 +1,71 @@
+package testutil_test
+
+import (
+	"context"
+	"testing"
+
+	v1 "review-service/api/review/v1"
+	"review-service/pkg/client/testutil"
+)
+
+// orderService 一个假想的订单服务，订单完成时替用户提交默认好评
+type orderService struct {
+	review v1.ReviewClient
+}
+
+func (s *orderService) CompleteOrder(ctx context.Context, userID, orderID, storeID int64) (int64, error) {
+	reply, err := s.review.CreateReview(ctx, &v1.CreateReviewRequest{
+		UserID:  userID,
+		OrderID: orderID,
+		StoreID: storeID,
+		Score:   5,
+		Content: "系统默认好评",
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reply.GetReviewID(), nil
+}
+
+func TestOrderServiceCreateReview(t *testing.T) {
+	tests := []struct {
+		name         string
+		builder      *testutil.FakeServerBuilder
+		wantReviewID int64
+		wantErr      func(error) bool
+	}{
+		{
+			name:         "created",
+			builder:      testutil.NewFakeServer().WithCreateReviewReturning(&v1.CreateReviewReply{ReviewID: 1001}),
+			wantReviewID: 1001,
+		},
+		{
+			name:    "order already reviewed",
+			builder: testutil.NewFakeServer().WithCreateReviewError(v1.ErrorOrderReviewed("订单已评价")),
+			wantErr: v1.IsOrderReviewed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := tt.builder.Build()
+			svc := &orderService{review: srv.Start(t)}
+
+			reviewID, err := svc.CompleteOrder(context.Background(), 7, 42, 3)
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Fatalf("CompleteOrder err = %v, want matching error", err)
+				}
+			} else if err != nil || reviewID != tt.wantReviewID {
+				t.Fatalf("CompleteOrder() = %d, %v, want %d, nil", reviewID, err, tt.wantReviewID)
+			}
+
+			calls := srv.CreateReviewCalls()
+			if len(calls) != 1 {
+				t.Fatalf("CreateReview called %d times, want 1", len(calls))
+			}
+			if c := calls[0]; c.GetUserID() != 7 || c.GetOrderID() != 42 || c.GetStoreID() != 3 {
+				t.Fatalf("CreateReview request = %v, want userID 7 orderID 42 storeID 3", c)
+			}
+		})
+	}
+}
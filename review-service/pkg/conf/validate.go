@@ -0,0 +1,98 @@
+package conf
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "review-service/internal/conf"
+	"review-service/pkg/snowflake/epoch"
+)
+
+// 启动时一次性校验所有配置项，把全部错误汇总返回，避免在初始化深处才panic
+
+const (
+	// maxMachineID 雪花算法节点ID占10位
+	maxMachineID = 1<<10 - 1
+)
+
+// FieldError 某个配置项的校验错误
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Reason)
+}
+
+// ValidateConfig 校验启动配置，返回所有不合法的配置项
+func ValidateConfig(c *pb.Bootstrap) []error {
+	var errs []error
+	add := func(field, format string, args ...interface{}) {
+		errs = append(errs, &FieldError{Field: field, Reason: fmt.Sprintf(format, args...)})
+	}
+
+	if c.GetServer().GetHttp().GetAddr() == "" {
+		add("server.http.addr", "不能为空")
+	}
+	if c.GetServer().GetGrpc().GetAddr() == "" {
+		add("server.grpc.addr", "不能为空")
+	}
+
+	db := c.GetData().GetDatabase()
+	switch strings.ToLower(db.GetDriver()) {
+	case "mysql", "sqlite":
+	default:
+		add("data.database.driver", "不支持的数据库驱动:%q", db.GetDriver())
+	}
+	if db.GetSource() == "" {
+		add("data.database.source", "不能为空")
+	}
+	if db.GetReplicaSource() != "" && db.GetMaxReplicaLag().AsDuration() <= 0 {
+		add("data.database.max_replica_lag", "配置replica_source时必须大于0")
+	}
+
+	sf := c.GetSnowflake()
+	if sf.GetRegion() != "" {
+		if _, err := epoch.EpochForRegion(sf.GetRegion()); err != nil {
+			add("snowflake.region", "支持的区域为cn/us/eu, 当前值:%q", sf.GetRegion())
+		}
+	} else if _, err := time.Parse("2006-01-02", sf.GetStartTime()); err != nil {
+		add("snowflake.start_time", "格式应为2006-01-02, 当前值:%q", sf.GetStartTime())
+	}
+	if id := sf.GetMachineId(); id <= 0 || id > maxMachineID {
+		add("snowflake.machine_id", "取值范围为[1, %d], 当前值:%d", maxMachineID, id)
+	}
+	if sf.GetMonitorInterval() != nil {
+		if sf.GetMonitorInterval().AsDuration() <= 0 {
+			add("snowflake.monitor_interval", "必须大于0")
+		}
+		// 开启冲突监控时依赖redis
+		if c.GetData().GetRedis().GetAddr() == "" {
+			add("data.redis.addr", "开启snowflake.monitor_interval时不能为空")
+		}
+	}
+	return errs
+}
+
+// FormatErrors 把校验错误格式化成表格，便于启动失败时阅读
+func FormatErrors(errs []error) string {
+	var b strings.Builder
+	width := len("FIELD")
+	for _, err := range errs {
+		if fe, ok := err.(*FieldError); ok && len(fe.Field) > width {
+			width = len(fe.Field)
+		}
+	}
+	fmt.Fprintf(&b, "配置校验失败，共%d项错误:\n", len(errs))
+	fmt.Fprintf(&b, "  %-*s  %s\n", width, "FIELD", "REASON")
+	for _, err := range errs {
+		if fe, ok := err.(*FieldError); ok {
+			fmt.Fprintf(&b, "  %-*s  %s\n", width, fe.Field, fe.Reason)
+		} else {
+			fmt.Fprintf(&b, "  %-*s  %s\n", width, "-", err.Error())
+		}
+	}
+	return b.String()
+}
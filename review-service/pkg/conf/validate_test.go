@@ -0,0 +1,105 @@
+package conf
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	pb "review-service/internal/conf"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// validBootstrap 一份能通过校验的最小配置
+func validBootstrap() *pb.Bootstrap {
+	return &pb.Bootstrap{
+		Server:    &pb.Server{Http: &pb.Server_HTTP{Addr: ":8000"}, Grpc: &pb.Server_GRPC{Addr: ":9000"}},
+		Data:      &pb.Data{Database: &pb.Data_Database{Driver: "sqlite", Source: "file::memory:"}},
+		Snowflake: &pb.Snowflake{StartTime: "2023-01-01", MachineId: 1},
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		modify     func(c *pb.Bootstrap)
+		wantFields []string
+	}{
+		{"valid", func(c *pb.Bootstrap) {}, nil},
+		{"empty db source", func(c *pb.Bootstrap) { c.Data.Database.Source = "" }, []string{"data.database.source"}},
+		{"unknown driver", func(c *pb.Bootstrap) { c.Data.Database.Driver = "postgres" }, []string{"data.database.driver"}},
+		{"machine id zero", func(c *pb.Bootstrap) { c.Snowflake.MachineId = 0 }, []string{"snowflake.machine_id"}},
+		{"machine id too large", func(c *pb.Bootstrap) { c.Snowflake.MachineId = maxMachineID + 1 }, []string{"snowflake.machine_id"}},
+		{"replica without max lag", func(c *pb.Bootstrap) { c.Data.Database.ReplicaSource = "replica" }, []string{"data.database.max_replica_lag"}},
+		{"monitor without redis", func(c *pb.Bootstrap) {
+			c.Snowflake.MonitorInterval = durationpb.New(time.Minute)
+		}, []string{"data.redis.addr"}},
+		{"all errors collected", func(c *pb.Bootstrap) {
+			c.Server = nil
+			c.Data.Database.Source = ""
+			c.Snowflake.MachineId = -1
+		}, []string{"server.http.addr", "server.grpc.addr", "data.database.source", "snowflake.machine_id"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validBootstrap()
+			tt.modify(c)
+			errs := ValidateConfig(c)
+			var fields []string
+			for _, err := range errs {
+				fe, ok := err.(*FieldError)
+				if !ok {
+					t.Fatalf("ValidateConfig() returned %T, want *FieldError", err)
+				}
+				fields = append(fields, fe.Field)
+			}
+			if fmt.Sprint(fields) != fmt.Sprint(tt.wantFields) {
+				t.Fatalf("ValidateConfig() fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	got := FormatErrors([]error{
+		&FieldError{Field: "data.database.source", Reason: "不能为空"},
+		&FieldError{Field: "snowflake.machine_id", Reason: "取值范围为[1, 1023], 当前值:0"},
+	})
+	want := "配置校验失败，共2项错误:\n" +
+		"  FIELD                 REASON\n" +
+		"  data.database.source  不能为空\n" +
+		"  snowflake.machine_id  取值范围为[1, 1023], 当前值:0\n"
+	if got != want {
+		t.Fatalf("FormatErrors() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestValidateSnowflake(t *testing.T) {
+	tests := []struct {
+		name      string
+		snowflake *pb.Snowflake
+		wantField string
+	}{
+		{"start time", &pb.Snowflake{StartTime: "2023-01-01", MachineId: 1}, ""},
+		{"bad start time", &pb.Snowflake{StartTime: "2023/01/01", MachineId: 1}, "snowflake.start_time"},
+		{"region without start time", &pb.Snowflake{Region: "EU", MachineId: 1}, ""},
+		{"unknown region", &pb.Snowflake{Region: "mars", MachineId: 1}, "snowflake.region"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validBootstrap()
+			c.Snowflake = tt.snowflake
+			errs := ValidateConfig(c)
+			if tt.wantField == "" {
+				if len(errs) != 0 {
+					t.Fatalf("ValidateConfig() = %v, want no errors", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || !strings.HasPrefix(errs[0].Error(), tt.wantField+":") {
+				t.Fatalf("ValidateConfig() = %v, want one error on %s", errs, tt.wantField)
+			}
+		})
+	}
+}
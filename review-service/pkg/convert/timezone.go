@@ -0,0 +1,40 @@
+package convert
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"review-service/internal/data/model"
+)
+
+// 评价者本地时区，以相对UTC的分钟数保存，展示创建时间时换算成评价者的本地时间
+
+const (
+	// MinTimezoneOffset 最小时区偏移(UTC-12:00)
+	MinTimezoneOffset = -720
+	// MaxTimezoneOffset 最大时区偏移(UTC+14:00)
+	MaxTimezoneOffset = 840
+)
+
+// ParseTimezoneOffset 解析x-user-timezone请求头（相对UTC的分钟数，如东京为540），为空时按UTC处理
+func ParseTimezoneOffset(s string) (int32, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	offset, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timezone offset %q", s)
+	}
+	if offset < MinTimezoneOffset || offset > MaxTimezoneOffset {
+		return 0, fmt.Errorf("timezone offset %d out of range [%d, %d]", offset, MinTimezoneOffset, MaxTimezoneOffset)
+	}
+	return int32(offset), nil
+}
+
+// LocalTime 评价创建时间换算成评价者的本地时间，不改变时间点，只改变时区
+func LocalTime(r *model.ReviewInfo) time.Time {
+	return r.CreateAt.In(time.FixedZone("", int(r.TimezoneOffset)*60))
+}
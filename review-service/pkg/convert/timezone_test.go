@@ -0,0 +1,56 @@
+package convert
+
+import (
+	"testing"
+	"time"
+
+	"review-service/internal/data/model"
+)
+
+func TestParseTimezoneOffset(t *testing.T) {
+	tests := []struct {
+		header  string
+		want    int32
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"540", 540, false},   // UTC+9 东京
+		{"-300", -300, false}, // UTC-5 纽约
+		{"-720", MinTimezoneOffset, false},
+		{"840", MaxTimezoneOffset, false},
+		{"900", 0, true},
+		{"-721", 0, true},
+		{"JST", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseTimezoneOffset(tt.header)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseTimezoneOffset(%q) = %d, %v, want %d, err:%v", tt.header, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestLocalTime(t *testing.T) {
+	createAt := time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		offset int32
+		want   string
+	}{
+		{"UTC+9", 540, "2026-03-01 10:00 +0900"},
+		{"UTC-5", -300, "2026-02-28 20:00 -0500"},
+		{"UTC", 0, "2026-03-01 01:00 +0000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LocalTime(&model.ReviewInfo{CreateAt: createAt, TimezoneOffset: tt.offset})
+			if s := got.Format("2006-01-02 15:04 -0700"); s != tt.want {
+				t.Fatalf("LocalTime() = %s, want %s", s, tt.want)
+			}
+			// 只换时区，时间点不变
+			if !got.Equal(createAt) {
+				t.Fatalf("LocalTime() = %v, want same instant as %v", got, createAt)
+			}
+		})
+	}
+}
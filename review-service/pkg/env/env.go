@@ -0,0 +1,37 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// 从环境变量读取配置，密码等敏感信息不写进配置文件
+// Require*在启动阶段调用，缺少环境变量时直接panic并给出变量名
+
+// RequireString 读取必填的字符串环境变量
+func RequireString(name string) string {
+	v, ok := os.LookupEnv(name)
+	if !ok || v == "" {
+		panic(fmt.Sprintf("env: required environment variable %s is not set", name))
+	}
+	return v
+}
+
+// RequireInt 读取必填的整数环境变量
+func RequireInt(name string) int {
+	v := RequireString(name)
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		panic(fmt.Sprintf("env: environment variable %s=%q is not an integer", name, v))
+	}
+	return n
+}
+
+// OptionalString 读取可选的字符串环境变量，未设置时返回defaultVal
+func OptionalString(name, defaultVal string) string {
+	if v, ok := os.LookupEnv(name); ok && v != "" {
+		return v
+	}
+	return defaultVal
+}
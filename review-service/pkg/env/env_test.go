@@ -0,0 +1,58 @@
+package env
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRead(t *testing.T) {
+	t.Setenv("TEST_ENV_STRING", "secret")
+	t.Setenv("TEST_ENV_INT", "42")
+	t.Setenv("TEST_ENV_EMPTY", "")
+
+	if got := RequireString("TEST_ENV_STRING"); got != "secret" {
+		t.Errorf("RequireString() = %q, want %q", got, "secret")
+	}
+	if got := RequireInt("TEST_ENV_INT"); got != 42 {
+		t.Errorf("RequireInt() = %d, want 42", got)
+	}
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"TEST_ENV_STRING", "secret"},
+		{"TEST_ENV_EMPTY", "default"},
+		{"TEST_ENV_UNSET", "default"},
+	}
+	for _, tt := range tests {
+		if got := OptionalString(tt.name, "default"); got != tt.want {
+			t.Errorf("OptionalString(%s) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRequirePanics(t *testing.T) {
+	t.Setenv("TEST_ENV_EMPTY", "")
+	t.Setenv("TEST_ENV_NOT_INT", "abc")
+	tests := []struct {
+		name    string
+		read    func()
+		wantMsg string
+	}{
+		{"missing string", func() { RequireString("TEST_ENV_UNSET") }, "TEST_ENV_UNSET is not set"},
+		{"empty string", func() { RequireString("TEST_ENV_EMPTY") }, "TEST_ENV_EMPTY is not set"},
+		{"missing int", func() { RequireInt("TEST_ENV_UNSET") }, "TEST_ENV_UNSET is not set"},
+		{"not an int", func() { RequireInt("TEST_ENV_NOT_INT") }, "is not an integer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				msg, _ := recover().(string)
+				if !strings.Contains(msg, tt.wantMsg) {
+					t.Fatalf("panic = %q, want message containing %q", msg, tt.wantMsg)
+				}
+			}()
+			tt.read()
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package errors
+
+import (
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 通用的资源不存在错误
+// metadata里带上查询的资源类型和ID，gRPC会通过status details(ErrorInfo)透传给调用方
+
+const (
+	// ReasonResourceNotFound 资源不存在
+	ReasonResourceNotFound = "RESOURCE_NOT_FOUND"
+
+	MetadataResource = "resource"
+	MetadataID       = "id"
+)
+
+// NotFoundError 创建资源不存在的错误
+func NotFoundError(resource string, id int64) *errors.Error {
+	return errors.NotFound(ReasonResourceNotFound, fmt.Sprintf("%s:%d不存在", resource, id)).
+		WithMetadata(map[string]string{
+			MetadataResource: resource,
+			MetadataID:       fmt.Sprint(id),
+		})
+}
+
+// IsNotFoundError 判断是否为资源不存在的错误
+func IsNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.FromError(err).Reason == ReasonResourceNotFound
+}
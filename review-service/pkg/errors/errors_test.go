@@ -0,0 +1,26 @@
+package errors
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// TestNotFoundErrorGRPCStatus 资源类型和ID通过gRPC status details透传给调用方
+func TestNotFoundErrorGRPCStatus(t *testing.T) {
+	err := NotFoundError("review", 42)
+	// 服务端返回的错误转换成gRPC status，调用方再从status还原
+	got := errors.FromError(err.GRPCStatus().Err())
+	if got.Code != 404 || got.Reason != ReasonResourceNotFound {
+		t.Fatalf("code:%d reason:%s, want 404 %s", got.Code, got.Reason, ReasonResourceNotFound)
+	}
+	if got.Metadata[MetadataResource] != "review" || got.Metadata[MetadataID] != "42" {
+		t.Fatalf("metadata = %v, want resource=review id=42", got.Metadata)
+	}
+	if !IsNotFoundError(got) {
+		t.Fatal("IsNotFoundError() = false, want true")
+	}
+	if IsNotFoundError(nil) || IsNotFoundError(errors.BadRequest("VALIDATOR", "bad")) {
+		t.Fatal("IsNotFoundError() = true for a non not-found error")
+	}
+}
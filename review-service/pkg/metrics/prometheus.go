@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// RPC耗时直方图，通过HTTP的/metrics暴露给Prometheus
+// Kubernetes HPA经Prometheus Adapter把它换算成p95_rpc_latency_ms做扩缩容，见deploy/helm/review-service
+
+// RPCLatencyMetric RPC耗时直方图的指标名，修改时需要同步修改helm chart中的adapter规则
+const RPCLatencyMetric = "rpc_latency_ms"
+
+var rpcLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    RPCLatencyMetric,
+	Help:    "服务端RPC处理耗时(毫秒)",
+	Buckets: []float64{5, 10, 25, 50, 100, 150, 250, 500, 1000, 2500},
+}, []string{"kind", "operation"})
+
+// Latency 记录每次RPC耗时的中间件
+func Latency() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			var kind, operation string
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				kind = tr.Kind().String()
+				operation = tr.Operation()
+			}
+			start := time.Now()
+			reply, err := handler(ctx, req)
+			rpcLatency.WithLabelValues(kind, operation).Observe(float64(time.Since(start)) / float64(time.Millisecond))
+			return reply, err
+		}
+	}
+}
+
+var (
+	writeThroughCacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "write_through_cache_hits_total",
+		Help: "评价写穿透缓存命中次数",
+	})
+	writeThroughCacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "write_through_cache_misses_total",
+		Help: "评价写穿透缓存未命中次数",
+	})
+)
+
+// WriteThroughCacheHit 记录一次评价缓存命中
+func WriteThroughCacheHit() { writeThroughCacheHits.Inc() }
+
+// WriteThroughCacheMiss 记录一次评价缓存未命中
+func WriteThroughCacheMiss() { writeThroughCacheMisses.Inc() }
+
+var replicationLag = promauto.NewHistogram(prometheus.HistogramOpts{
+	Name:    "review_replication_lag_ms",
+	Help:    "新写入主库的评价出现在从库上的耗时(毫秒)",
+	Buckets: []float64{10, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000},
+})
+
+// ReplicationLag 记录一次复制延迟探测的结果
+func ReplicationLag(d time.Duration) {
+	replicationLag.Observe(float64(d) / float64(time.Millisecond))
+}
@@ -0,0 +1,138 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// 小规模部署不需要prometheus时，用原子计数器汇总最近一分钟的业务指标，供管理后台以JSON形式读取
+// 计数器每个周期清零一次，创建评价的平均耗时使用指数加权移动平均（EWMA），不随周期清零
+
+const (
+	// DefaultResetInterval 计数器清零周期
+	DefaultResetInterval = time.Minute
+	// ewmaAlpha 新样本的权重，越大越接近最近的耗时
+	ewmaAlpha = 0.2
+)
+
+// Summary 业务指标汇总
+type Summary struct {
+	reviewsCreated  atomic.Int64
+	reviewsApproved atomic.Int64
+	reviewsRejected atomic.Int64
+	apiErrors       atomic.Int64
+	// createLatency EWMA平均耗时(毫秒)，按float64位存储
+	createLatency atomic.Uint64
+}
+
+// Snapshot 某一时刻的指标值
+type Snapshot struct {
+	ReviewsCreated     int64   `json:"reviews_created"`
+	ReviewsApproved    int64   `json:"reviews_approved"`
+	ReviewsRejected    int64   `json:"reviews_rejected"`
+	APIErrors          int64   `json:"api_errors"`
+	AvgCreateLatencyMs float64 `json:"avg_create_latency_ms"`
+}
+
+var defaultSummary = NewSummary()
+
+// NewSummary .
+func NewSummary() *Summary {
+	return &Summary{}
+}
+
+// Default 返回全局的指标汇总
+func Default() *Summary {
+	return defaultSummary
+}
+
+// ReviewCreated 记录一次创建评价及其耗时
+func (s *Summary) ReviewCreated(cost time.Duration) {
+	s.reviewsCreated.Add(1)
+	ms := float64(cost) / float64(time.Millisecond)
+	for {
+		old := s.createLatency.Load()
+		avg := math.Float64frombits(old)
+		if old == 0 {
+			avg = ms
+		} else {
+			avg = ewmaAlpha*ms + (1-ewmaAlpha)*avg
+		}
+		if s.createLatency.CompareAndSwap(old, math.Float64bits(avg)) {
+			return
+		}
+	}
+}
+
+// ReviewApproved 记录一次审核通过
+func (s *Summary) ReviewApproved() { s.reviewsApproved.Add(1) }
+
+// ReviewRejected 记录一次审核不通过
+func (s *Summary) ReviewRejected() { s.reviewsRejected.Add(1) }
+
+// APIError 记录一次接口返回错误
+func (s *Summary) APIError() { s.apiErrors.Add(1) }
+
+// Snapshot 返回当前周期内的指标
+func (s *Summary) Snapshot() Snapshot {
+	return Snapshot{
+		ReviewsCreated:     s.reviewsCreated.Load(),
+		ReviewsApproved:    s.reviewsApproved.Load(),
+		ReviewsRejected:    s.reviewsRejected.Load(),
+		APIErrors:          s.apiErrors.Load(),
+		AvgCreateLatencyMs: math.Float64frombits(s.createLatency.Load()),
+	}
+}
+
+// Reset 计数器清零
+func (s *Summary) Reset() {
+	s.reviewsCreated.Store(0)
+	s.reviewsApproved.Store(0)
+	s.reviewsRejected.Store(0)
+	s.apiErrors.Store(0)
+}
+
+// Start 启动后台协程按周期清零计数器，ctx取消时退出
+func (s *Summary) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.Reset()
+			}
+		}
+	}()
+}
+
+// Handler 以JSON格式输出当前指标，挂载到 GET /admin/metrics
+func (s *Summary) Handler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.Snapshot())
+}
+
+// Server 统计接口返回错误次数的中间件
+func Server(s *Summary) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+			if err != nil {
+				s.APIError()
+			}
+			return reply, err
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func getSnapshot(t *testing.T, s *Summary) Snapshot {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	s.Handler(rec, httptest.NewRequest(http.MethodGet, "/admin/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("Content-Type = %q, want application/json", ct)
+	}
+	var snap Snapshot
+	if err := json.Unmarshal(rec.Body.Bytes(), &snap); err != nil {
+		t.Fatalf("decode body %q: %v", rec.Body.String(), err)
+	}
+	return snap
+}
+
+func TestSummaryHandler(t *testing.T) {
+	tests := []struct {
+		name      string
+		latencies []time.Duration
+		approved  int
+		rejected  int
+		errors    int
+		want      Snapshot
+	}{
+		{
+			name: "empty",
+			want: Snapshot{},
+		},
+		{
+			// 第一个样本直接作为平均值
+			name:      "first sample",
+			latencies: []time.Duration{100 * time.Millisecond},
+			want:      Snapshot{ReviewsCreated: 1, AvgCreateLatencyMs: 100},
+		},
+		{
+			// 100 -> 0.2*200+0.8*100=120 -> 0.2*50+0.8*120=106
+			name:      "ewma",
+			latencies: []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 50 * time.Millisecond},
+			approved:  2,
+			rejected:  1,
+			errors:    4,
+			want:      Snapshot{ReviewsCreated: 3, ReviewsApproved: 2, ReviewsRejected: 1, APIErrors: 4, AvgCreateLatencyMs: 106},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSummary()
+			for _, cost := range tt.latencies {
+				s.ReviewCreated(cost)
+			}
+			for i := 0; i < tt.approved; i++ {
+				s.ReviewApproved()
+			}
+			for i := 0; i < tt.rejected; i++ {
+				s.ReviewRejected()
+			}
+			for i := 0; i < tt.errors; i++ {
+				s.APIError()
+			}
+			got := getSnapshot(t, s)
+			if math.Abs(got.AvgCreateLatencyMs-tt.want.AvgCreateLatencyMs) > 1e-9 {
+				t.Fatalf("avg_create_latency_ms = %v, want %v", got.AvgCreateLatencyMs, tt.want.AvgCreateLatencyMs)
+			}
+			got.AvgCreateLatencyMs = tt.want.AvgCreateLatencyMs
+			if got != tt.want {
+				t.Fatalf("snapshot = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummaryPeriodicReset(t *testing.T) {
+	s := NewSummary()
+	s.ReviewCreated(80 * time.Millisecond)
+	s.ReviewApproved()
+	s.ReviewRejected()
+	s.APIError()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx, 10*time.Millisecond)
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		got := getSnapshot(t, s)
+		if got.ReviewsCreated == 0 && got.ReviewsApproved == 0 && got.ReviewsRejected == 0 && got.APIErrors == 0 {
+			// 平均耗时不随周期清零
+			if got.AvgCreateLatencyMs != 80 {
+				t.Fatalf("avg_create_latency_ms after reset = %v, want 80", got.AvgCreateLatencyMs)
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("counters not reset within 1s: %+v", got)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestSummaryHandlerMethod(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewSummary().Handler(rec, httptest.NewRequest(http.MethodPost, "/admin/metrics", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status = %d, want 405", rec.Code)
+	}
+}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	kratosmiddleware "github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// 管理接口（运维工具、O端）要求请求头里带上与服务端一致的管理密钥
+// 服务端没有配置密钥时拒绝所有管理接口的请求，避免忘记配置时接口裸奔
+
+// AdminKeyHeader 管理密钥的请求头（gRPC为metadata）
+const AdminKeyHeader = "x-admin-key"
+
+// ErrAdminKeyInvalid 管理密钥缺失或不匹配
+var ErrAdminKeyInvalid = errors.Unauthorized("ADMIN_KEY_INVALID", "管理密钥无效")
+
+// AdminKey 校验请求头中的管理密钥，配合selector只用在管理接口上
+func AdminKey(key string) kratosmiddleware.Middleware {
+	return func(handler kratosmiddleware.Handler) kratosmiddleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if !IsAdmin(ctx, key) {
+				return nil, ErrAdminKeyInvalid
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// IsAdmin 请求头中的管理密钥与key一致时返回true，key为空时始终返回false
+func IsAdmin(ctx context.Context, key string) bool {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return false
+	}
+	return adminKeyMatch(tr.RequestHeader().Get(AdminKeyHeader), key)
+}
+
+// AdminKeyHandler 给不经过kratos中间件的原生HTTP handler校验管理密钥，校验失败时用encode输出ErrAdminKeyInvalid
+func AdminKeyHandler(key string, h http.Handler, encode func(http.ResponseWriter, *http.Request, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !adminKeyMatch(r.Header.Get(AdminKeyHeader), key) {
+			encode(w, r, ErrAdminKeyInvalid)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func adminKeyMatch(got, key string) bool {
+	if key == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(got), []byte(key)) == 1
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestAdminKeyHandler(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	encode := func(w http.ResponseWriter, r *http.Request, err error) {
+		w.WriteHeader(int(errors.FromError(err).Code))
+	}
+	tests := []struct {
+		name   string
+		key    string
+		header string
+		want   int
+	}{
+		{"right key", "secret", "secret", http.StatusOK},
+		{"missing key", "secret", "", http.StatusUnauthorized},
+		{"wrong key", "secret", "guess", http.StatusUnauthorized},
+		{"server key not configured", "", "", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/admin/metrics", nil)
+			if tt.header != "" {
+				req.Header.Set(AdminKeyHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			AdminKeyHandler(tt.key, ok, encode).ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc"
+)
+
+// 按显式优先级组合gRPC拦截器，避免零散注册导致执行顺序不确定
+// priority越小越靠外层（越先执行），priority相同时按注册顺序执行
+
+type chainEntry struct {
+	name        string
+	interceptor grpc.UnaryServerInterceptor
+	priority    int
+	seq         int
+}
+
+// ChainBuilder gRPC一元拦截器链构造器
+type ChainBuilder struct {
+	entries map[string]*chainEntry
+	seq     int
+}
+
+// NewChainBuilder .
+func NewChainBuilder() *ChainBuilder {
+	return &ChainBuilder{entries: make(map[string]*chainEntry)}
+}
+
+// Add 注册拦截器，同名拦截器会被覆盖
+func (b *ChainBuilder) Add(name string, i grpc.UnaryServerInterceptor, priority int) *ChainBuilder {
+	b.seq++
+	b.entries[name] = &chainEntry{
+		name:        name,
+		interceptor: i,
+		priority:    priority,
+		seq:         b.seq,
+	}
+	return b
+}
+
+// Must 获取已注册的拦截器，未注册时panic
+func (b *ChainBuilder) Must(name string) grpc.UnaryServerInterceptor {
+	e, ok := b.entries[name]
+	if !ok {
+		panic(fmt.Sprintf("middleware: interceptor %q not registered", name))
+	}
+	return e.interceptor
+}
+
+// Interceptors 返回按优先级排好序的拦截器
+func (b *ChainBuilder) Interceptors() []grpc.UnaryServerInterceptor {
+	entries := make([]*chainEntry, 0, len(b.entries))
+	for _, e := range b.entries {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].priority != entries[j].priority {
+			return entries[i].priority < entries[j].priority
+		}
+		return entries[i].seq < entries[j].seq
+	})
+	interceptors := make([]grpc.UnaryServerInterceptor, 0, len(entries))
+	for _, e := range entries {
+		interceptors = append(interceptors, e.interceptor)
+	}
+	return interceptors
+}
+
+// Build 生成grpc.ChainUnaryInterceptor选项
+func (b *ChainBuilder) Build() grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(b.Interceptors()...)
+}
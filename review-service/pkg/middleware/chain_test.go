@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// recordInterceptor 执行时把名字追加到seq，用来观察拦截器的执行顺序
+func recordInterceptor(name string, seq *[]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		*seq = append(*seq, name)
+		return handler(ctx, req)
+	}
+}
+
+// callThroughServer 用Build生成的选项启动gRPC服务，调用一次健康检查接口
+func callThroughServer(t *testing.T, b *ChainBuilder) {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(b.Build())
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial fail, err:%v", err)
+	}
+	defer conn.Close()
+	if _, err := grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{}); err != nil {
+		t.Fatalf("health check fail, err:%v", err)
+	}
+}
+
+func TestChainBuilderOrder(t *testing.T) {
+	type entry struct {
+		name     string
+		priority int
+	}
+	tests := []struct {
+		name    string
+		entries []entry
+		want    []string
+	}{
+		{"sorted by priority", []entry{{"auth", 10}, {"recovery", 0}, {"logging", 5}}, []string{"recovery", "logging", "auth"}},
+		{"same priority keeps registration order", []entry{{"a", 1}, {"b", 1}, {"c", 0}}, []string{"c", "a", "b"}},
+		{"re-register replaces", []entry{{"a", 0}, {"b", 1}, {"a", 2}}, []string{"b", "a"}},
+		{"empty", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seq []string
+			b := NewChainBuilder()
+			for _, e := range tt.entries {
+				b.Add(e.name, recordInterceptor(e.name, &seq), e.priority)
+			}
+			callThroughServer(t, b)
+			if fmt.Sprint(seq) != fmt.Sprint(tt.want) {
+				t.Fatalf("execution order = %v, want %v", seq, tt.want)
+			}
+		})
+	}
+}
+
+func TestChainBuilderMust(t *testing.T) {
+	var seq []string
+	b := NewChainBuilder().Add("logging", recordInterceptor("logging", &seq), 0)
+	if b.Must("logging") == nil {
+		t.Fatal("Must(logging) = nil, want registered interceptor")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Must(missing) did not panic")
+		}
+	}()
+	b.Must("missing")
+}
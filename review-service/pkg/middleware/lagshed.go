@@ -0,0 +1,146 @@
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	v1 "review-service/api/review/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 从库延迟过高时主动拒绝写请求，读请求不受影响
+// LagMonitor定期在从库上执行SHOW SLAVE STATUS记录延迟，LagShedInterceptor根据延迟决定是否拒绝写请求
+
+// lagUnknown 从库复制未运行或查询失败时的延迟值，此时不拒绝请求
+const lagUnknown = -1
+
+// writeMethods 会被拒绝的写接口
+var writeMethods = map[string]struct{}{
+	v1.Review_CreateReview_FullMethodName:      {},
+	v1.Review_AuditReview_FullMethodName:       {},
+	v1.Review_ReplyReview_FullMethodName:       {},
+	v1.Review_AppealReview_FullMethodName:      {},
+	v1.Review_AuditAppeal_FullMethodName:       {},
+	v1.Review_BulkTagReviews_FullMethodName:    {},
+	v1.Review_RequestWithdrawal_FullMethodName: {},
+	v1.Review_ApproveWithdrawal_FullMethodName: {},
+	v1.Review_DenyWithdrawal_FullMethodName:    {},
+	// 评价向导的每一步都会写会话，提交时创建评价
+	v1.Review_StartReviewSession_FullMethodName:  {},
+	v1.Review_UpdateReviewSession_FullMethodName: {},
+	v1.Review_CommitReviewSession_FullMethodName: {},
+}
+
+// LagSource 提供从库复制延迟(毫秒)，未知时返回-1
+type LagSource interface {
+	LagMs() int64
+}
+
+// LagMonitor 从库复制延迟监控
+type LagMonitor struct {
+	db    *sql.DB
+	lagMs atomic.Int64
+	stop  chan struct{}
+}
+
+// NewLagMonitor 启动从库延迟监控，每interval查询一次
+func NewLagMonitor(replica *sql.DB, interval time.Duration) *LagMonitor {
+	m := &LagMonitor{db: replica, stop: make(chan struct{})}
+	m.lagMs.Store(lagUnknown)
+	go m.run(interval)
+	return m
+}
+
+// LagMs 最近一次查询到的从库延迟(毫秒)，未知时返回-1
+func (m *LagMonitor) LagMs() int64 {
+	return m.lagMs.Load()
+}
+
+// Close 停止监控
+func (m *LagMonitor) Close() {
+	close(m.stop)
+}
+
+func (m *LagMonitor) run(interval time.Duration) {
+	m.refresh()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+			m.refresh()
+		}
+	}
+}
+
+func (m *LagMonitor) refresh() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	lag, err := m.queryLag(ctx)
+	if err != nil {
+		log.Errorf("LagMonitor query replica status fail, err:%v", err)
+		lag = lagUnknown
+	}
+	m.lagMs.Store(lag)
+}
+
+// queryLag 解析SHOW SLAVE STATUS中的Seconds_Behind_Master（MySQL 8.0.22之后为Seconds_Behind_Source）
+func (m *LagMonitor) queryLag(ctx context.Context) (int64, error) {
+	rows, err := m.db.QueryContext(ctx, "SHOW SLAVE STATUS")
+	if err != nil {
+		return lagUnknown, err
+	}
+	defer rows.Close()
+	cols, err := rows.Columns()
+	if err != nil {
+		return lagUnknown, err
+	}
+	if !rows.Next() {
+		// 不是从库
+		return lagUnknown, rows.Err()
+	}
+	values := make([]sql.RawBytes, len(cols))
+	dest := make([]interface{}, len(cols))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return lagUnknown, err
+	}
+	for i, col := range cols {
+		if col != "Seconds_Behind_Master" && col != "Seconds_Behind_Source" {
+			continue
+		}
+		// NULL表示复制线程未运行
+		if values[i] == nil {
+			return lagUnknown, nil
+		}
+		seconds, err := strconv.ParseInt(string(values[i]), 10, 64)
+		if err != nil {
+			return lagUnknown, err
+		}
+		return seconds * 1000, nil
+	}
+	return lagUnknown, nil
+}
+
+// LagShedInterceptor 从库延迟超过maxLagMs时以codes.Unavailable拒绝写请求
+func LagShedInterceptor(monitor LagSource, maxLagMs int64) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := writeMethods[info.FullMethod]; ok {
+			if lag := monitor.LagMs(); lag > maxLagMs {
+				return nil, status.Errorf(codes.Unavailable, "replica lag %dms exceeds %dms, write rejected", lag, maxLagMs)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	v1 "review-service/api/review/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubLag 固定延迟的LagSource
+type stubLag int64
+
+func (l stubLag) LagMs() int64 { return int64(l) }
+
+func TestLagShedInterceptor(t *testing.T) {
+	const maxLagMs = 1000
+	writes := []string{
+		v1.Review_CreateReview_FullMethodName,
+		v1.Review_AuditReview_FullMethodName,
+		v1.Review_BulkTagReviews_FullMethodName,
+		v1.Review_RequestWithdrawal_FullMethodName,
+		v1.Review_StartReviewSession_FullMethodName,
+		v1.Review_UpdateReviewSession_FullMethodName,
+		v1.Review_CommitReviewSession_FullMethodName,
+	}
+	reads := []string{
+		v1.Review_GetReview_FullMethodName,
+		v1.Review_ListReviewByUserID_FullMethodName,
+		v1.Review_GetPlatformReport_FullMethodName,
+	}
+	tests := []struct {
+		name        string
+		lag         stubLag
+		rejectRead  bool
+		rejectWrite bool
+	}{
+		{"no lag", 0, false, false},
+		{"at threshold", maxLagMs, false, false},
+		{"high lag", maxLagMs + 1, false, true},
+		{"unknown lag", lagUnknown, false, false},
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := LagShedInterceptor(tt.lag, maxLagMs)
+			check := func(method string, wantReject bool) {
+				reply, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+				if wantReject {
+					if status.Code(err) != codes.Unavailable {
+						t.Errorf("%s: err = %v, want Unavailable", method, err)
+					}
+					return
+				}
+				if err != nil || reply != "ok" {
+					t.Errorf("%s: reply = %v err = %v, want pass through", method, reply, err)
+				}
+			}
+			for _, method := range writes {
+				check(method, tt.rejectWrite)
+			}
+			for _, method := range reads {
+				check(method, tt.rejectRead)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+
+	v1 "review-service/api/review/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	kratosmiddleware "github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// 只属于某个用户的接口（比如个人数据导出）只允许该用户本人或者带管理密钥的请求访问
+// 登录由网关负责，网关校验登录态后把当前用户id写入x-user-id请求头，并丢弃客户端自己传的同名请求头
+
+// UserIDHeader 网关写入的当前登录用户id
+const UserIDHeader = "x-user-id"
+
+// ErrUserMismatch 当前登录用户与请求的用户不一致
+var ErrUserMismatch = errors.Forbidden("USER_MISMATCH", "只能访问自己的数据")
+
+// userScoped 请求参数中带有数据所属的用户id
+type userScoped interface {
+	GetUserID() int64
+}
+
+// SelfOrAdmin 请求的userID必须是当前登录用户，带有效管理密钥的请求不受限制
+func SelfOrAdmin(adminKey string) kratosmiddleware.Middleware {
+	return func(handler kratosmiddleware.Handler) kratosmiddleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if IsAdmin(ctx, adminKey) {
+				return handler(ctx, req)
+			}
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, v1.ErrorNeedLogin("请先登录")
+			}
+			callerID, err := strconv.ParseInt(tr.RequestHeader().Get(UserIDHeader), 10, 64)
+			if err != nil || callerID <= 0 {
+				return nil, v1.ErrorNeedLogin("请先登录")
+			}
+			r, ok := req.(userScoped)
+			if !ok || r.GetUserID() != callerID {
+				return nil, ErrUserMismatch
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package shard
+
+// 按store_id对评价数据分库
+// 同一个店铺的评价落在同一个库里，按店铺查询时只需要访问一个分片
+
+// Resolve 计算storeID所在的分片下标，返回值范围为[0, numShards)
+func Resolve(storeID int64, numShards int) int {
+	if numShards <= 1 {
+		return 0
+	}
+	idx := storeID % int64(numShards)
+	if idx < 0 {
+		idx += int64(numShards)
+	}
+	return int(idx)
+}
@@ -0,0 +1,27 @@
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HMAC-SHA256签名，签名结果为小写十六进制字符串
+
+// Sign 用key对data签名
+func Sign(key, data []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 校验签名，使用常量时间比较
+func Verify(key, data []byte, sig string) bool {
+	expected, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
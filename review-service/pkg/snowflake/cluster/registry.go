@@ -0,0 +1,220 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"review-service/pkg/snowflake"
+
+	"github.com/go-kratos/kratos/v2/log"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// 通过etcd租约在集群内分配唯一的雪花算法machineID
+//
+// {prefix}/ids/{machineID}  值为节点名，绑定租约，节点下线租约过期后自动释放
+// {prefix}/nodes/{nodeName} 值为上次分配到的machineID，不绑定租约，节点重启后优先重新占用同一个machineID
+//
+// 租约续期失败后立即停止生成ID，防止租约过期后machineID被其他节点占用导致ID重复
+
+const (
+	defaultPrefix = "/snowflake"
+	defaultTTL    = 10 // 秒
+	// machineID取值范围，snowflake.New不接受0
+	minMachineID = 1
+	maxMachineID = 1<<10 - 1
+)
+
+var (
+	// ErrLeaseExpired 租约已失效，不能再生成ID
+	ErrLeaseExpired = errors.New("cluster: machine id lease expired")
+	// ErrNoMachineID 没有可用的machineID
+	ErrNoMachineID = errors.New("cluster: no machine id available")
+)
+
+// Option 配置项
+type Option func(r *ClusterNodeRegistry)
+
+// WithPrefix etcd key前缀
+func WithPrefix(prefix string) Option {
+	return func(r *ClusterNodeRegistry) { r.prefix = prefix }
+}
+
+// WithTTL 租约有效期(秒)
+func WithTTL(ttl int64) Option {
+	return func(r *ClusterNodeRegistry) { r.ttl = ttl }
+}
+
+// ClusterNodeRegistry 基于etcd的machineID注册中心
+type ClusterNodeRegistry struct {
+	cli      *clientv3.Client
+	nodeName string
+	prefix   string
+	ttl      int64
+
+	mu        sync.Mutex
+	leaseID   clientv3.LeaseID
+	machineID int64
+	gen       *snowflake.Snowflake
+	expired   atomic.Bool
+	cancel    context.CancelFunc
+}
+
+// NewClusterNodeRegistry nodeName需要在节点重启后保持不变（例如StatefulSet的Pod名）
+func NewClusterNodeRegistry(cli *clientv3.Client, nodeName string, opts ...Option) *ClusterNodeRegistry {
+	r := &ClusterNodeRegistry{
+		cli:      cli,
+		nodeName: nodeName,
+		prefix:   defaultPrefix,
+		ttl:      defaultTTL,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Claim 占用一个machineID并创建ID生成器，之后后台自动续租
+func (r *ClusterNodeRegistry) Claim(ctx context.Context, startTime string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lease, err := r.cli.Grant(ctx, r.ttl)
+	if err != nil {
+		return 0, err
+	}
+	id, err := r.claim(ctx, lease.ID)
+	if err != nil {
+		r.cli.Revoke(context.Background(), lease.ID)
+		return 0, err
+	}
+	gen, err := snowflake.New(startTime, id)
+	if err != nil {
+		r.cli.Revoke(context.Background(), lease.ID)
+		return 0, err
+	}
+
+	kaCtx, cancel := context.WithCancel(context.Background())
+	ch, err := r.cli.KeepAlive(kaCtx, lease.ID)
+	if err != nil {
+		cancel()
+		r.cli.Revoke(context.Background(), lease.ID)
+		return 0, err
+	}
+	r.leaseID, r.machineID, r.gen, r.cancel = lease.ID, id, gen, cancel
+	r.expired.Store(false)
+	go r.keepAlive(ch)
+	return id, nil
+}
+
+// claim 优先占用上次分配的machineID，被占用时顺序查找空闲的machineID
+func (r *ClusterNodeRegistry) claim(ctx context.Context, leaseID clientv3.LeaseID) (int64, error) {
+	resp, err := r.cli.Get(ctx, r.nodeKey())
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Kvs) > 0 {
+		if prev, perr := strconv.ParseInt(string(resp.Kvs[0].Value), 10, 64); perr == nil {
+			ok, err := r.tryClaim(ctx, prev, leaseID)
+			if err != nil {
+				return 0, err
+			}
+			if ok {
+				return prev, nil
+			}
+		}
+	}
+	for id := int64(minMachineID); id <= maxMachineID; id++ {
+		ok, err := r.tryClaim(ctx, id, leaseID)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			return id, nil
+		}
+	}
+	return 0, ErrNoMachineID
+}
+
+// tryClaim 事务占用machineID：key不存在，或者key仍属于本节点（上次的租约还未过期）
+func (r *ClusterNodeRegistry) tryClaim(ctx context.Context, id int64, leaseID clientv3.LeaseID) (bool, error) {
+	idKey := r.idKey(id)
+	put := []clientv3.Op{
+		clientv3.OpPut(idKey, r.nodeName, clientv3.WithLease(leaseID)),
+		clientv3.OpPut(r.nodeKey(), strconv.FormatInt(id, 10)),
+	}
+	resp, err := r.cli.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(idKey), "=", 0)).
+		Then(put...).
+		Else(clientv3.OpGet(idKey)).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	if resp.Succeeded {
+		return true, nil
+	}
+	// 上次的租约还没过期，占用者就是本节点，直接换成新租约
+	kvs := resp.Responses[0].GetResponseRange().GetKvs()
+	if len(kvs) == 0 || string(kvs[0].Value) != r.nodeName {
+		return false, nil
+	}
+	resp, err = r.cli.Txn(ctx).
+		If(clientv3.Compare(clientv3.Value(idKey), "=", r.nodeName)).
+		Then(put...).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	return resp.Succeeded, nil
+}
+
+func (r *ClusterNodeRegistry) keepAlive(ch <-chan *clientv3.LeaseKeepAliveResponse) {
+	for range ch {
+	}
+	// channel关闭说明续租失败或已主动关闭
+	if r.expired.CompareAndSwap(false, true) {
+		log.Errorf("snowflake cluster lease lost, node:%s machineID:%d, stop generating ids", r.nodeName, r.machineID)
+	}
+}
+
+// GenID 生成ID，租约失效后返回ErrLeaseExpired
+func (r *ClusterNodeRegistry) GenID() (int64, error) {
+	if r.gen == nil || r.expired.Load() {
+		return 0, ErrLeaseExpired
+	}
+	return r.gen.GenID(), nil
+}
+
+// MachineID 当前占用的machineID，租约失效后返回ErrLeaseExpired
+func (r *ClusterNodeRegistry) MachineID() (int64, error) {
+	if r.gen == nil || r.expired.Load() {
+		return 0, ErrLeaseExpired
+	}
+	return r.machineID, nil
+}
+
+// Close 停止续租并释放machineID，节点名到machineID的映射保留，重启后可以重新占用
+func (r *ClusterNodeRegistry) Close(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cancel == nil {
+		return nil
+	}
+	r.cancel()
+	r.expired.Store(true)
+	_, err := r.cli.Revoke(ctx, r.leaseID)
+	return err
+}
+
+func (r *ClusterNodeRegistry) idKey(id int64) string {
+	return fmt.Sprintf("%s/ids/%d", r.prefix, id)
+}
+
+func (r *ClusterNodeRegistry) nodeKey() string {
+	return fmt.Sprintf("%s/nodes/%s", r.prefix, r.nodeName)
+}
@@ -0,0 +1,157 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+)
+
+const testStartTime = "2023-01-01"
+
+// freeURL 找一个空闲端口，embed要求通告地址和监听地址一致，不能直接用0端口
+func freeURL(t *testing.T) url.URL {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen fail, err:%v", err)
+	}
+	defer lis.Close()
+	return url.URL{Scheme: "http", Host: lis.Addr().String()}
+}
+
+// newTestEtcd 启动一个单节点的内嵌etcd，返回连接它的客户端，测试结束时关闭
+func newTestEtcd(t *testing.T) *clientv3.Client {
+	t.Helper()
+	cfg := embed.NewConfig()
+	cfg.Dir = t.TempDir()
+	cfg.LogLevel = "error"
+	clientURL, peerURL := freeURL(t), freeURL(t)
+	cfg.ListenClientUrls, cfg.AdvertiseClientUrls = []url.URL{clientURL}, []url.URL{clientURL}
+	cfg.ListenPeerUrls, cfg.AdvertisePeerUrls = []url.URL{peerURL}, []url.URL{peerURL}
+	cfg.InitialCluster = fmt.Sprintf("%s=%s", cfg.Name, peerURL.String())
+
+	e, err := embed.StartEtcd(cfg)
+	if err != nil {
+		t.Fatalf("start etcd fail, err:%v", err)
+	}
+	t.Cleanup(e.Close)
+	select {
+	case <-e.Server.ReadyNotify():
+	case <-time.After(10 * time.Second):
+		t.Fatal("etcd not ready after 10s")
+	}
+
+	cli, err := clientv3.New(clientv3.Config{Endpoints: []string{clientURL.String()}, DialTimeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("new etcd client fail, err:%v", err)
+	}
+	t.Cleanup(func() { cli.Close() })
+	return cli
+}
+
+func claim(t *testing.T, r *ClusterNodeRegistry) int64 {
+	t.Helper()
+	id, err := r.Claim(context.Background(), testStartTime)
+	if err != nil {
+		t.Fatalf("Claim node %s fail, err:%v", r.nodeName, err)
+	}
+	return id
+}
+
+func TestClaimUniqueMachineID(t *testing.T) {
+	cli := newTestEtcd(t)
+	seen := make(map[int64]string)
+	for _, node := range []string{"pod-0", "pod-1", "pod-2"} {
+		r := NewClusterNodeRegistry(cli, node)
+		t.Cleanup(func() { r.Close(context.Background()) })
+		id := claim(t, r)
+		if other, ok := seen[id]; ok {
+			t.Fatalf("node %s claimed machineID %d already held by %s", node, id, other)
+		}
+		seen[id] = node
+		if _, err := r.GenID(); err != nil {
+			t.Fatalf("GenID on node %s fail, err:%v", node, err)
+		}
+	}
+}
+
+func TestReclaimAfterRestart(t *testing.T) {
+	cli := newTestEtcd(t)
+	ctx := context.Background()
+
+	first := NewClusterNodeRegistry(cli, "pod-0")
+	id := claim(t, first)
+	other := NewClusterNodeRegistry(cli, "pod-1")
+	t.Cleanup(func() { other.Close(ctx) })
+	claim(t, other)
+
+	// 进程崩溃时租约还没过期，重启后直接接管原来的machineID
+	crashed := NewClusterNodeRegistry(cli, "pod-0")
+	if got := claim(t, crashed); got != id {
+		t.Fatalf("reclaim with live lease = %d, want %d", got, id)
+	}
+	first.Close(ctx)
+	crashed.Close(ctx)
+
+	// 正常下线释放了machineID，重启后仍然拿回同一个
+	restarted := NewClusterNodeRegistry(cli, "pod-0")
+	t.Cleanup(func() { restarted.Close(ctx) })
+	if got := claim(t, restarted); got != id {
+		t.Fatalf("reclaim after close = %d, want %d", got, id)
+	}
+}
+
+func TestReclaimTakenByOtherNode(t *testing.T) {
+	cli := newTestEtcd(t)
+	ctx := context.Background()
+
+	first := NewClusterNodeRegistry(cli, "pod-0")
+	id := claim(t, first)
+	first.Close(ctx)
+
+	// 下线期间machineID被新节点占用，重启后换一个空闲的machineID
+	other := NewClusterNodeRegistry(cli, "pod-1")
+	t.Cleanup(func() { other.Close(ctx) })
+	if got := claim(t, other); got != id {
+		t.Fatalf("new node claimed %d, want released machineID %d", got, id)
+	}
+	restarted := NewClusterNodeRegistry(cli, "pod-0")
+	t.Cleanup(func() { restarted.Close(ctx) })
+	if got := claim(t, restarted); got == id {
+		t.Fatalf("restarted node reclaimed machineID %d held by pod-1", got)
+	}
+}
+
+func TestLeaseExpiredStopsGenID(t *testing.T) {
+	cli := newTestEtcd(t)
+	// 缩短租约，续租请求每秒发一次
+	r := NewClusterNodeRegistry(cli, "pod-0", WithTTL(3))
+	t.Cleanup(func() { r.Close(context.Background()) })
+	claim(t, r)
+
+	// 模拟租约在etcd侧失效，续租失败后不能再生成ID
+	if _, err := cli.Revoke(context.Background(), r.leaseID); err != nil {
+		t.Fatalf("revoke lease fail, err:%v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_, err := r.GenID()
+		if errors.Is(err, ErrLeaseExpired) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("GenID 5s after lease revoked err = %v, want ErrLeaseExpired", err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if _, err := r.MachineID(); !errors.Is(err, ErrLeaseExpired) {
+		t.Fatalf("MachineID after lease revoked err = %v, want ErrLeaseExpired", err)
+	}
+}
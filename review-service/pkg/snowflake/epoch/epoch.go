@@ -0,0 +1,36 @@
+package epoch
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 各区域部署使用的雪花算法起始时间（Unix毫秒），均为该区域标准时区的2023-01-01 00:00:00
+// 同一区域的所有节点必须使用同一个epoch，否则生成的ID会重复或乱序
+//
+// 配置snowflake.region后服务用snowflake.InitWithEpoch(EpochForRegion(region))初始化，不再使用按UTC解析的start_time；
+// 同一区域内不能有的节点配置region、有的节点配置start_time
+
+const (
+	// ChinaEpoch Asia/Shanghai (UTC+8) 2023-01-01 00:00:00
+	ChinaEpoch int64 = 1672502400000
+	// USEpoch America/New_York (UTC-5) 2023-01-01 00:00:00
+	USEpoch int64 = 1672549200000
+	// EUEpoch Europe/Berlin (UTC+1) 2023-01-01 00:00:00
+	EUEpoch int64 = 1672527600000
+)
+
+var regions = map[string]int64{
+	"cn": ChinaEpoch,
+	"us": USEpoch,
+	"eu": EUEpoch,
+}
+
+// EpochForRegion 按区域（cn/us/eu，不区分大小写）查询epoch
+func EpochForRegion(region string) (int64, error) {
+	epoch, ok := regions[strings.ToLower(strings.TrimSpace(region))]
+	if !ok {
+		return 0, fmt.Errorf("epoch: unknown region %q", region)
+	}
+	return epoch, nil
+}
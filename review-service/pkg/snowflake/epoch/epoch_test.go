@@ -0,0 +1,53 @@
+package epoch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEpochConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		epoch    int64
+		location string
+	}{
+		{"ChinaEpoch", ChinaEpoch, "Asia/Shanghai"},
+		{"USEpoch", USEpoch, "America/New_York"},
+		{"EUEpoch", EUEpoch, "Europe/Berlin"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.location)
+			if err != nil {
+				t.Skipf("load location %s fail, err:%v", tt.location, err)
+			}
+			start, err := time.ParseInLocation("2006-01-02 15:04:05", "2023-01-01 00:00:00", loc)
+			if err != nil {
+				t.Fatalf("ParseInLocation fail, err:%v", err)
+			}
+			if got := start.UnixMilli(); got != tt.epoch {
+				t.Fatalf("%s = %d, want %d (2023-01-01 00:00:00 %s)", tt.name, tt.epoch, got, tt.location)
+			}
+		})
+	}
+}
+
+func TestEpochForRegion(t *testing.T) {
+	tests := []struct {
+		region  string
+		want    int64
+		wantErr bool
+	}{
+		{"cn", ChinaEpoch, false},
+		{"US", USEpoch, false},
+		{" eu ", EUEpoch, false},
+		{"jp", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := EpochForRegion(tt.region)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("EpochForRegion(%q) = (%d, %v), want (%d, err=%v)", tt.region, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/redis/go-redis/v9"
+)
+
+// 多节点部署时，如果两个节点配置了相同的machineID，生成的ID就可能重复。
+// 监控协程定期生成一个ID写入Redis有序集合（按machineID分key，score为ID），
+// 再检查上一次生成的ID到本次ID之间是否出现了其他节点写入的ID，出现即说明ID区间重叠。
+// 注意：Redis的score是float64，ID的低位会损失精度，所以这里检查的是区间重叠而不是单个ID相等。
+
+const (
+	monitorKeyPrefix = "snowflake:monitor:"
+	// monitorKeepSize 每个machineID保留的最近ID数量
+	monitorKeepSize = 1000
+	monitorKeyTTL   = 24 * time.Hour
+)
+
+var collisionDetectedTotal = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "snowflake_collision_detected_total",
+	Help: "相同machineID的节点生成的ID区间出现重叠的次数",
+})
+
+// StartMonitor 启动雪花算法ID冲突监控，ctx取消时退出
+func StartMonitor(ctx context.Context, gen *Snowflake, redisClient *redis.Client, interval time.Duration) {
+	m := &monitor{
+		gen:      gen,
+		rdb:      redisClient,
+		key:      monitorKeyPrefix + strconv.FormatInt(gen.MachineID(), 10),
+		instance: instanceName(),
+	}
+	go m.run(ctx, interval)
+}
+
+type monitor struct {
+	gen      *Snowflake
+	rdb      *redis.Client
+	key      string
+	instance string
+	lastID   int64
+}
+
+func (m *monitor) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.check(ctx); err != nil {
+				log.Errorf("snowflake monitor check fail, err:%v", err)
+			}
+		}
+	}
+}
+
+// check 生成一个ID，检查(lastID, id]区间内是否有其他节点写入的ID
+func (m *monitor) check(ctx context.Context) error {
+	id := m.gen.GenID()
+	min := m.lastID
+	if min == 0 {
+		min = id - 1
+	}
+	members, err := m.rdb.ZRangeByScore(ctx, m.key, &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(min, 10),
+		Max: strconv.FormatInt(id, 10),
+	}).Result()
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		if !strings.HasPrefix(member, m.instance+":") {
+			collisionDetectedTotal.Inc()
+			log.Errorf("snowflake collision detected, machineID:%d id:%d other:%s", m.gen.MachineID(), id, member)
+			break
+		}
+	}
+	m.lastID = id
+
+	pipe := m.rdb.TxPipeline()
+	pipe.ZAdd(ctx, m.key, redis.Z{Score: float64(id), Member: fmt.Sprintf("%s:%d", m.instance, id)})
+	pipe.ZRemRangeByRank(ctx, m.key, 0, -monitorKeepSize-1)
+	pipe.Expire(ctx, m.key, monitorKeyTTL)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// instanceName 当前进程的唯一标识，用于区分写入同一个key的不同节点
+func instanceName() string {
+	host, _ := os.Hostname()
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
@@ -0,0 +1,59 @@
+package snowflake
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/redis/go-redis/v9"
+)
+
+// TestMonitorCollision 两个节点使用相同的machineID时，后检查的节点能发现对方写入的ID
+func TestMonitorCollision(t *testing.T) {
+	const (
+		epochMillis int64 = 1672531200000 // 2023-01-01 00:00:00 UTC
+		machineID   int64 = 5
+	)
+	tests := []struct {
+		name string
+		// checks 依次执行检查的节点
+		checks []string
+		want   float64
+	}{
+		{"single node", []string{"node-a", "node-a", "node-a"}, 0},
+		{"shared machine id", []string{"node-a", "node-b", "node-a"}, 1},
+		{"both nodes see each other", []string{"node-a", "node-b", "node-a", "node-b"}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr := miniredis.RunT(t)
+			rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+			defer rdb.Close()
+			ctx := context.Background()
+
+			monitors := make(map[string]*monitor)
+			before := testutil.ToFloat64(collisionDetectedTotal)
+			for _, instance := range tt.checks {
+				m, ok := monitors[instance]
+				if !ok {
+					gen, err := NewWithEpoch(epochMillis, machineID)
+					if err != nil {
+						t.Fatalf("NewWithEpoch fail, err:%v", err)
+					}
+					m = &monitor{gen: gen, rdb: rdb, key: monitorKeyPrefix + "5", instance: instance}
+					monitors[instance] = m
+				}
+				if err := m.check(ctx); err != nil {
+					t.Fatalf("check fail, err:%v", err)
+				}
+				// 相邻两次检查间隔几毫秒，避免float64精度把不同节点的ID合成同一个score
+				time.Sleep(2 * time.Millisecond)
+			}
+			if got := testutil.ToFloat64(collisionDetectedTotal) - before; got != tt.want {
+				t.Fatalf("snowflake_collision_detected_total increased by %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
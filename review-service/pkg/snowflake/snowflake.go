@@ -19,24 +19,67 @@ var (
 	InvalidTimeFormatErr = errors.New("snowflake初始化失败，无效的startTime格式")
 )
 
-var node *sf.Node
+// Snowflake 雪花算法ID生成器
+type Snowflake struct {
+	node      *sf.Node
+	machineID int64
+}
 
-// Init 雪花算法初始化配置
-func Init(startTime string, machineID int64) (err error) {
+var defaultSnowflake *Snowflake
+
+// New 创建一个雪花算法ID生成器，startTime按UTC解析
+func New(startTime string, machineID int64) (*Snowflake, error) {
 	if len(startTime) == 0 || machineID <= 0 {
-		return InvalidInitParamErr
+		return nil, InvalidInitParamErr
 	}
-	var st time.Time
-	st, err = time.Parse("2006-01-02", startTime)
+	st, err := time.Parse("2006-01-02", startTime)
 	if err != nil {
-		return InvalidTimeFormatErr
+		return nil, InvalidTimeFormatErr
 	}
-	sf.Epoch = st.UnixNano() / 1000000
-	node, err = sf.NewNode(machineID)
+	return NewWithEpoch(st.UnixNano()/1000000, machineID)
+}
+
+// NewWithEpoch 使用Unix毫秒的起始时间创建ID生成器，各区域的epoch见epoch子包
+func NewWithEpoch(epochMillis int64, machineID int64) (*Snowflake, error) {
+	if epochMillis <= 0 || machineID <= 0 {
+		return nil, InvalidInitParamErr
+	}
+	sf.Epoch = epochMillis
+	node, err := sf.NewNode(machineID)
+	if err != nil {
+		return nil, err
+	}
+	return &Snowflake{node: node, machineID: machineID}, nil
+}
+
+// GenID 生成一个ID
+func (s *Snowflake) GenID() int64 {
+	return s.node.Generate().Int64()
+}
+
+// MachineID 返回生成器使用的机器ID
+func (s *Snowflake) MachineID() int64 {
+	return s.machineID
+}
+
+// Init 雪花算法初始化配置
+func Init(startTime string, machineID int64) (err error) {
+	defaultSnowflake, err = New(startTime, machineID)
 	return
 }
 
+// InitWithEpoch 使用Unix毫秒的起始时间初始化默认生成器
+func InitWithEpoch(epochMillis int64, machineID int64) (err error) {
+	defaultSnowflake, err = NewWithEpoch(epochMillis, machineID)
+	return
+}
+
+// Default 返回Init初始化的默认生成器
+func Default() *Snowflake {
+	return defaultSnowflake
+}
+
 // GenID 生一个ID
 func GenID() int64 {
-	return node.Generate().Int64()
+	return defaultSnowflake.GenID()
 }
@@ -0,0 +1,86 @@
+package snowflake
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+var initOnce sync.Once
+
+func setup(tb testing.TB) {
+	tb.Helper()
+	var err error
+	initOnce.Do(func() {
+		err = Init("2023-01-01", 1)
+	})
+	if err != nil {
+		tb.Fatalf("Init fail, err:%v", err)
+	}
+}
+
+// TestGetIDConcurrency 并发调用GenID，生成的ID不能重复
+func TestGetIDConcurrency(t *testing.T) {
+	setup(t)
+	const (
+		goroutines = 1000
+		perRoutine = 100
+	)
+	ids := make(chan int64, goroutines*perRoutine)
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perRoutine; j++ {
+				ids <- GenID()
+			}
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	seen := make(map[int64]struct{}, goroutines*perRoutine)
+	for id := range ids {
+		if _, ok := seen[id]; ok {
+			t.Fatalf("duplicate id:%d", id)
+		}
+		seen[id] = struct{}{}
+	}
+	if len(seen) != goroutines*perRoutine {
+		t.Fatalf("got %d ids, want %d", len(seen), goroutines*perRoutine)
+	}
+}
+
+func BenchmarkGetIDParallel(b *testing.B) {
+	setup(b)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			GenID()
+		}
+	})
+}
+
+// TestNewWithEpoch ID的时间戳部分从epoch开始计算
+func TestNewWithEpoch(t *testing.T) {
+	const epochMillis int64 = 1672549200000 // 2023-01-01 00:00:00 America/New_York
+	s, err := NewWithEpoch(epochMillis, 3)
+	if err != nil {
+		t.Fatalf("NewWithEpoch fail, err:%v", err)
+	}
+	before := time.Now().UnixMilli()
+	id := s.GenID()
+	after := time.Now().UnixMilli()
+	// 低22位是10位节点ID和12位序列号
+	if got := id>>22 + epochMillis; got < before || got > after {
+		t.Fatalf("id timestamp = %d, want within [%d, %d]", got, before, after)
+	}
+	if node := id >> 12 & (1<<10 - 1); node != 3 {
+		t.Fatalf("id node = %d, want 3", node)
+	}
+	for _, tt := range []struct{ epoch, machineID int64 }{{0, 1}, {epochMillis, 0}} {
+		if _, err := NewWithEpoch(tt.epoch, tt.machineID); err != InvalidInitParamErr {
+			t.Errorf("NewWithEpoch(%d, %d) err = %v, want InvalidInitParamErr", tt.epoch, tt.machineID, err)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package startup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// 按依赖顺序串行执行启动阶段，例如 DB → Redis → Snowflake → RPC服务
+// 某个阶段失败后，直接或间接依赖它的阶段都会跳过，不依赖它的阶段照常执行
+
+// Stage 启动阶段
+type Stage struct {
+	Name      string
+	DependsOn []string
+	Run       func(ctx context.Context) error
+}
+
+// Sequencer 启动阶段编排
+type Sequencer struct {
+	stages []Stage
+}
+
+// NewSequencer .
+func NewSequencer(stages ...Stage) *Sequencer {
+	return &Sequencer{stages: stages}
+}
+
+// Add 添加阶段
+func (s *Sequencer) Add(stage Stage) *Sequencer {
+	s.stages = append(s.stages, stage)
+	return s
+}
+
+// StageError 执行失败的阶段以及因此跳过的阶段
+type StageError struct {
+	Failed  map[string]error
+	Skipped []string
+}
+
+func (e *StageError) Error() string {
+	failed := make([]string, 0, len(e.Failed))
+	for name, err := range e.Failed {
+		failed = append(failed, fmt.Sprintf("%s: %v", name, err))
+	}
+	return fmt.Sprintf("startup: stages failed [%s], skipped [%s]", strings.Join(failed, "; "), strings.Join(e.Skipped, ", "))
+}
+
+// Run 按拓扑顺序串行执行所有阶段，有阶段失败时返回*StageError
+// 阶段名重复、依赖了不存在的阶段或存在循环依赖时不执行任何阶段，直接返回错误
+func (s *Sequencer) Run(ctx context.Context) error {
+	order, err := s.sort()
+	if err != nil {
+		return err
+	}
+	var (
+		stageErr = &StageError{Failed: map[string]error{}}
+		// blocked 失败或被跳过的阶段
+		blocked = map[string]bool{}
+	)
+	for _, stage := range order {
+		if dep := firstBlocked(stage.DependsOn, blocked); dep != "" {
+			blocked[stage.Name] = true
+			stageErr.Skipped = append(stageErr.Skipped, stage.Name)
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := stage.Run(ctx); err != nil {
+			blocked[stage.Name] = true
+			stageErr.Failed[stage.Name] = err
+		}
+	}
+	if len(stageErr.Failed) > 0 {
+		return stageErr
+	}
+	return nil
+}
+
+func firstBlocked(deps []string, blocked map[string]bool) string {
+	for _, dep := range deps {
+		if blocked[dep] {
+			return dep
+		}
+	}
+	return ""
+}
+
+// sort Kahn拓扑排序，没有依赖关系的阶段保持添加顺序
+func (s *Sequencer) sort() ([]Stage, error) {
+	index := make(map[string]int, len(s.stages))
+	for i, stage := range s.stages {
+		if _, ok := index[stage.Name]; ok {
+			return nil, fmt.Errorf("startup: duplicate stage %q", stage.Name)
+		}
+		index[stage.Name] = i
+	}
+	indegree := make([]int, len(s.stages))
+	dependents := make([][]int, len(s.stages))
+	for i, stage := range s.stages {
+		for _, dep := range stage.DependsOn {
+			j, ok := index[dep]
+			if !ok {
+				return nil, fmt.Errorf("startup: stage %q depends on unknown stage %q", stage.Name, dep)
+			}
+			indegree[i]++
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+	order := make([]Stage, 0, len(s.stages))
+	done := make([]bool, len(s.stages))
+	for len(order) < len(s.stages) {
+		// 每次取添加顺序最靠前的入度为0的阶段
+		next := -1
+		for i := range s.stages {
+			if !done[i] && indegree[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			return nil, fmt.Errorf("startup: dependency cycle among stages")
+		}
+		done[next] = true
+		order = append(order, s.stages[next])
+		for _, d := range dependents[next] {
+			indegree[d]--
+		}
+	}
+	return order, nil
+}
@@ -0,0 +1,64 @@
+package startup
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSequencerSkipsDependents(t *testing.T) {
+	var ran []string
+	stage := func(name string, err error, deps ...string) Stage {
+		return Stage{Name: name, DependsOn: deps, Run: func(context.Context) error {
+			ran = append(ran, name)
+			return err
+		}}
+	}
+	errRedis := errors.New("redis unavailable")
+	// 添加顺序与依赖顺序相反，执行时按依赖排序
+	seq := NewSequencer(
+		stage("servers", nil, "snowflake"),
+		stage("snowflake", nil, "redis"),
+		stage("redis", errRedis, "db"),
+		stage("db", nil),
+	)
+	err := seq.Run(context.Background())
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("Run() err = %v, want *StageError", err)
+	}
+	if !reflect.DeepEqual(ran, []string{"db", "redis"}) {
+		t.Fatalf("ran stages = %v, want [db redis]", ran)
+	}
+	if len(stageErr.Failed) != 1 || stageErr.Failed["redis"] != errRedis {
+		t.Fatalf("failed stages = %v, want redis", stageErr.Failed)
+	}
+	if !reflect.DeepEqual(stageErr.Skipped, []string{"snowflake", "servers"}) {
+		t.Fatalf("skipped stages = %v, want [snowflake servers]", stageErr.Skipped)
+	}
+}
+
+func TestSequencerInvalidStages(t *testing.T) {
+	tests := []struct {
+		name   string
+		stages []Stage
+	}{
+		{"duplicate", []Stage{{Name: "db"}, {Name: "db"}}},
+		{"unknown dependency", []Stage{{Name: "redis", DependsOn: []string{"db"}}}},
+		{"cycle", []Stage{{Name: "a", DependsOn: []string{"b"}}, {Name: "b", DependsOn: []string{"a"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ran := false
+			for i := range tt.stages {
+				tt.stages[i].Run = func(context.Context) error { ran = true; return nil }
+			}
+			err := NewSequencer(tt.stages...).Run(context.Background())
+			if err == nil || ran {
+				t.Fatalf("Run() err = %v ran = %v, want an error before running any stage", err, ran)
+			}
+		})
+	}
+}
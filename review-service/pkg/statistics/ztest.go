@@ -0,0 +1,57 @@
+package statistics
+
+import "math"
+
+// Z检验：比较最近一段时间与之前较长一段时间的均值，判断变化是否显著
+// 以较长的基准期的标准差作为总体标准差的估计
+
+const (
+	// CriticalZ95 双侧95%置信度的临界值
+	CriticalZ95 = 1.960
+	// CriticalZ99 双侧99%置信度的临界值
+	CriticalZ99 = 2.576
+)
+
+// ZScore 计算样本1(均值mean1, 样本数n1)相对样本2(均值mean2, 标准差std2, 样本数n2)的Z值
+// 标准误差为 std2 * sqrt(1/n1 + 1/n2)，任一样本为空或std2为0时返回0
+func ZScore(mean1, mean2, std2 float64, n1, n2 int) float64 {
+	if n1 <= 0 || n2 <= 0 || std2 == 0 {
+		return 0
+	}
+	se := std2 * math.Sqrt(1/float64(n1)+1/float64(n2))
+	return (mean1 - mean2) / se
+}
+
+// ZTestSentimentAlert 比较最近的情感得分recent（如最近7天）和基准期baseline（如之前30天），
+// 返回Z值以及在99%置信度下变化是否显著
+func ZTestSentimentAlert(recent, baseline []float64) (z float64, isSignificant bool) {
+	mean1 := Mean(recent)
+	mean2 := Mean(baseline)
+	z = ZScore(mean1, mean2, StdDev(baseline), len(recent), len(baseline))
+	return z, math.Abs(z) > CriticalZ99
+}
+
+// Mean 均值，空切片返回0
+func Mean(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
+
+// StdDev 样本标准差（n-1），少于2个样本时返回0
+func StdDev(xs []float64) float64 {
+	if len(xs) < 2 {
+		return 0
+	}
+	mean := Mean(xs)
+	var sum float64
+	for _, x := range xs {
+		sum += (x - mean) * (x - mean)
+	}
+	return math.Sqrt(sum / float64(len(xs)-1))
+}
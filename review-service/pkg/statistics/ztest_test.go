@@ -0,0 +1,64 @@
+package statistics
+
+import (
+	"math"
+	"testing"
+)
+
+func TestZScore(t *testing.T) {
+	tests := []struct {
+		name               string
+		mean1, mean2, std2 float64
+		n1, n2             int
+		want               float64
+	}{
+		// 0.5 / (0.5 * sqrt(1/7 + 1/30)) = 1 / sqrt(0.176190...)
+		{"drop", 4.0, 4.5, 0.5, 7, 30, -2.3824},
+		{"rise", 4.5, 4.0, 0.5, 7, 30, 2.3824},
+		// 1 / (2 * sqrt(1/100 + 1/100)) = 1 / (2 * 0.141421...)
+		{"equal samples", 11, 10, 2, 100, 100, 3.5355},
+		{"same mean", 4.2, 4.2, 0.3, 7, 30, 0},
+		{"empty recent", 4.0, 4.5, 0.5, 0, 30, 0},
+		{"empty baseline", 4.0, 4.5, 0.5, 7, 0, 0},
+		{"zero std", 4.0, 4.5, 0, 7, 30, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ZScore(tt.mean1, tt.mean2, tt.std2, tt.n1, tt.n2)
+			if math.Abs(got-tt.want) > 1e-4 {
+				t.Fatalf("ZScore() = %.4f, want %.4f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZTestSentimentAlert(t *testing.T) {
+	// 基准期30天日均评分在4.0和5.0之间交替：均值4.5，样本标准差sqrt(7.5/29)=0.5085，标准误差0.5085*sqrt(1/7+1/30)=0.2135
+	baseline := make([]float64, 30)
+	for i := range baseline {
+		baseline[i] = 4.0 + float64(i%2)
+	}
+	tests := []struct {
+		name            string
+		recent          []float64
+		wantZ           float64
+		wantSignificant bool
+	}{
+		// -1.0 / 0.2135 = -4.6847
+		{"significant drop", []float64{3.5, 3.5, 3.5, 3.5, 3.5, 3.5, 3.5}, -4.6847, true},
+		// -0.5 / 0.2135 = -2.3423，只在95%置信度下显著
+		{"drop below 99%", []float64{4, 4, 4, 4, 4, 4, 4}, -2.3423, false},
+		{"stable", []float64{4.5, 4.5, 4.5, 4.5, 4.5, 4.5, 4.5}, 0, false},
+		// (5.0714 - 4.5) / 0.2135 = 2.6769
+		{"significant rise", []float64{5, 5, 5, 5, 5, 5, 5.5}, 2.6769, true},
+		{"no recent reviews", nil, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z, significant := ZTestSentimentAlert(tt.recent, baseline)
+			if math.Abs(z-tt.wantZ) > 1e-4 || significant != tt.wantSignificant {
+				t.Fatalf("ZTestSentimentAlert() = (%.4f, %v), want (%.4f, %v)", z, significant, tt.wantZ, tt.wantSignificant)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package testutil
+
+import (
+	"testing"
+
+	"review-service/internal/data/model"
+)
+
+func TestNewIsolatedDBIndependent(t *testing.T) {
+	a := NewIsolatedDB(t)
+	b := NewIsolatedDB(t)
+
+	review := &model.ReviewInfo{ReviewID: 1, Content: "isolated review", OrderID: 1, UserID: 1, StoreID: 1}
+	if err := a.Create(review).Error; err != nil {
+		t.Fatalf("create review in db a fail, err:%v", err)
+	}
+
+	var countA, countB int64
+	if err := a.Model(&model.ReviewInfo{}).Count(&countA).Error; err != nil {
+		t.Fatalf("count db a fail, err:%v", err)
+	}
+	if err := b.Model(&model.ReviewInfo{}).Count(&countB).Error; err != nil {
+		t.Fatalf("count db b fail, err:%v", err)
+	}
+	if countA != 1 || countB != 0 {
+		t.Fatalf("databases are not isolated, countA:%d countB:%d", countA, countB)
+	}
+}
+
+func TestNewIsolatedDBUniqueKeys(t *testing.T) {
+	db := NewIsolatedDB(t)
+
+	first := &model.ReviewInfo{ReviewID: 1, Content: "first review", OrderID: 1}
+	if err := db.Create(first).Error; err != nil {
+		t.Fatalf("create review fail, err:%v", err)
+	}
+	// review.sql中的uk_review_id必须生效
+	dup := &model.ReviewInfo{ReviewID: 1, Content: "second review", OrderID: 2}
+	if err := db.Create(dup).Error; err == nil {
+		t.Fatal("duplicate review_id was accepted, uk_review_id is missing")
+	}
+}
@@ -22,7 +22,7 @@ CREATE TABLE review_info (
         `tags` varchar(1024) NOT NULL DEFAULT '' COMMENT '标签json',
         `pic_info` varchar(1024) NOT NULL DEFAULT '' COMMENT '媒体信息：图片',
         `video_info` varchar(1024) NOT NULL DEFAULT '' COMMENT '媒体信息：视频',
-        `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10待审核；20审核通过；30审核不通过；40隐藏',
+        `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10待审核；20审核通过；30审核不通过；40隐藏；50撤回审核中；60已撤回',
         `is_default` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否默认评价',
         `has_reply` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否有商家回复:0无;1有',
         `op_reason` varchar(512) NOT NULL DEFAULT '' COMMENT '运营审核拒绝原因',
@@ -32,11 +32,19 @@ CREATE TABLE review_info (
         `goods_snapshoot` varchar(2048) NOT NULL DEFAULT '' COMMENT '商品快照信息',
         `ext_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '信息扩展',
         `ctrl_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '控制扩展',
+        `content_hash` char(64) COMMENT '内容哈希:sha256(spu_id:小写去空格的内容)，允许重复内容时为NULL',
+        `timezone_offset` smallint(6) NOT NULL DEFAULT '0' COMMENT '评价者时区:相对UTC的分钟数',
+        `anomaly_score` double NOT NULL DEFAULT '0' COMMENT '异常分数:孤立森林打分，取值[0,1]',
+        `schema_version` smallint(6) NOT NULL DEFAULT '1' COMMENT '结构版本',
+        `is_probe` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否复制延迟探测行:0否;1是',
+        `is_verified_purchase` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否验证购买:0否;1是，确认下单用户与评价用户一致后写入',
         PRIMARY KEY (`id`),
         KEY `idx_delete_at` (`delete_at`) COMMENT '逻辑删除索引',
         UNIQUE KEY `uk_review_id` (`review_id`) COMMENT '评价id索引',
         KEY `idx_order_id` (`order_id`) COMMENT '订单id索引',
-        KEY `idx_user_id` (`user_id`) COMMENT '用户id索引'
+        KEY `idx_user_id` (`user_id`) COMMENT '用户id索引',
+        UNIQUE KEY `uk_content_hash` (`content_hash`, `spu_id`) COMMENT '同一商品评价内容去重',
+        KEY `idx_store_anomaly` (`store_id`, `anomaly_score`) COMMENT '店铺异常评价索引'
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价表';
 
 CREATE TABLE review_reply_info (
@@ -93,4 +101,104 @@ CREATE TABLE review_appeal_info (
         KEY `idx_appeal_id` (`appeal_id`) COMMENT '申诉id索引',
         UNIQUE KEY `uk_review_id` (`review_id`) COMMENT '评价id索引',
         KEY `idx_store_id` (`store_id`) COMMENT '店铺id索引'
-)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价商家申诉表';
\ No newline at end of file
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价商家申诉表';
+
+CREATE TABLE review_tag_info (
+        `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+        `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建方标识',
+        `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新方标识',
+        `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+        `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+        `delete_at` timestamp COMMENT '逻辑删除标记',
+        `version` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '乐观锁标记',
+
+        `tag_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '标签id',
+        `name` varchar(64) NOT NULL DEFAULT '' COMMENT '标签名称',
+
+        `ext_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '信息扩展',
+        `ctrl_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '控制扩展',
+        PRIMARY KEY (`id`),
+        KEY `idx_delete_at` (`delete_at`) COMMENT '逻辑删除索引',
+        UNIQUE KEY `uk_tag_id` (`tag_id`) COMMENT '标签id索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价标签表';
+
+CREATE TABLE review_tag_map (
+        `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+        `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建方标识',
+        `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新方标识',
+        `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+        `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+        `delete_at` timestamp COMMENT '逻辑删除标记',
+        `version` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '乐观锁标记',
+
+        `review_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '评价id',
+        `tag_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '标签id',
+        PRIMARY KEY (`id`),
+        KEY `idx_delete_at` (`delete_at`) COMMENT '逻辑删除索引',
+        UNIQUE KEY `uk_review_tag` (`review_id`, `tag_id`) COMMENT '评价标签唯一索引',
+        KEY `idx_tag_id` (`tag_id`) COMMENT '标签id索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价标签关联表';
+
+CREATE TABLE review_creation_rollup (
+        `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+        `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建方标识',
+        `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新方标识',
+        `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+        `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+        `delete_at` timestamp COMMENT '逻辑删除标记',
+        `version` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '乐观锁标记',
+
+        `hour` datetime NOT NULL COMMENT '统计小时（整点）',
+        `store_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '店铺id',
+        `review_count` bigint(32) NOT NULL DEFAULT '0' COMMENT '该小时内创建的评价数',
+        PRIMARY KEY (`id`),
+        KEY `idx_delete_at` (`delete_at`) COMMENT '逻辑删除索引',
+        UNIQUE KEY `uk_store_hour` (`store_id`, `hour`) COMMENT '店铺小时唯一索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价创建数小时汇总表';
+
+CREATE TABLE review_withdrawal_info (
+        `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+        `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建方标识',
+        `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新方标识',
+        `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+        `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+        `delete_at` timestamp COMMENT '逻辑删除标记',
+        `version` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '乐观锁标记',
+
+        `withdrawal_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '撤回申请id',
+        `review_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '评价id',
+        `user_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '用户id',
+        `reason` varchar(255) NOT NULL COMMENT '撤回原因',
+        `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10待审核；20同意撤回；30拒绝撤回',
+
+        `op_remarks` varchar(512) NOT NULL DEFAULT '' COMMENT '运营备注',
+        `op_user` varchar(64) NOT NULL DEFAULT '' COMMENT '运营者标识',
+
+        `ext_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '信息扩展',
+        `ctrl_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '控制扩展',
+        PRIMARY KEY (`id`),
+        KEY `idx_delete_at` (`delete_at`) COMMENT '逻辑删除索引',
+        UNIQUE KEY `uk_withdrawal_id` (`withdrawal_id`) COMMENT '撤回申请id索引',
+        UNIQUE KEY `uk_review_id` (`review_id`) COMMENT '每个评价只能申请一次撤回',
+        KEY `idx_user_id` (`user_id`) COMMENT '用户id索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价撤回申请表';
+
+CREATE TABLE review_audit_log (
+        `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+        `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建方标识',
+        `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新方标识',
+        `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+        `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+        `delete_at` timestamp COMMENT '逻辑删除标记',
+        `version` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '乐观锁标记',
+
+        `log_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '操作日志id',
+        `op_user` varchar(64) NOT NULL DEFAULT '' COMMENT '运营者标识',
+        `action` varchar(32) NOT NULL DEFAULT '' COMMENT '操作类型',
+        `params` varchar(1024) NOT NULL DEFAULT '' COMMENT '操作参数(json)',
+        `affected` bigint(32) NOT NULL DEFAULT '0' COMMENT '影响的记录数',
+        PRIMARY KEY (`id`),
+        KEY `idx_delete_at` (`delete_at`) COMMENT '逻辑删除索引',
+        UNIQUE KEY `uk_log_id` (`log_id`) COMMENT '操作日志id索引',
+        KEY `idx_op_user` (`op_user`) COMMENT '运营者索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价运营操作日志表';